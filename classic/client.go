@@ -17,11 +17,22 @@ type service struct {
 }
 
 type services struct {
-	ComputerExtensionAttributes *ComputerExtensionAttributesService
-	ComputerGroups              *ComputerGroupsService
-	OSXConfigurationProfiles    *OSXConfigurationProfilesService
-	Packages                    *PackagesService
-	Policies                    *PoliciesService
+	CommandFlush                      *CommandFlushService
+	ComputerCommands                  *ComputerCommandsService
+	ComputerExtensionAttributes       *ComputerExtensionAttributesService
+	ComputerGroups                    *ComputerGroupsService
+	Computers                         *ComputersService
+	MacApplications                   *MacApplicationsService
+	MobileDeviceApplications          *MobileDeviceApplicationsService
+	MobileDeviceCommands              *MobileDeviceCommandsService
+	MobileDeviceConfigurationProfiles *MobileDeviceConfigurationProfilesService
+	MobileDeviceGroups                *MobileDeviceGroupsService
+	MobileDevices                     *MobileDevicesService
+	OSXConfigurationProfiles          *OSXConfigurationProfilesService
+	Packages                          *PackagesService
+	PatchPolicies                     *PatchPoliciesService
+	PatchSoftwareTitles               *PatchSoftwareTitlesService
+	Policies                          *PoliciesService
 }
 
 type Client struct {
@@ -33,15 +44,19 @@ type Client struct {
 
 const apiEndpointPath = "/JSSResource"
 
-func NewClient(serverURL string) (*Client, error) {
+func NewClient(serverURL string, opts ...jamf.ClientOption) (*Client, error) {
 	u, err := url.Parse(serverURL)
 	if err != nil {
-		return nil, fmt.Errorf("url.Parse(): %v", err)
+		return nil, fmt.Errorf("url.Parse(): %w", err)
 	}
 	u.Path = path.Join(u.Path, apiEndpointPath)
 
 	client := jamf.NewBaseClient(u)
 	client.DefaultContentType = "application/xml; charset=utf-8"
+	client.APIFamily = jamf.APIFamilyClassic
+	for _, opt := range opts {
+		opt(client)
+	}
 
 	c := &Client{
 		BaseClient: client,
@@ -49,10 +64,21 @@ func NewClient(serverURL string) (*Client, error) {
 
 	c.common.client = c
 
+	c.CommandFlush = (*CommandFlushService)(&c.common)
+	c.ComputerCommands = (*ComputerCommandsService)(&c.common)
 	c.ComputerExtensionAttributes = (*ComputerExtensionAttributesService)(&c.common)
 	c.ComputerGroups = (*ComputerGroupsService)(&c.common)
+	c.Computers = (*ComputersService)(&c.common)
+	c.MacApplications = (*MacApplicationsService)(&c.common)
+	c.MobileDeviceApplications = (*MobileDeviceApplicationsService)(&c.common)
+	c.MobileDeviceCommands = (*MobileDeviceCommandsService)(&c.common)
+	c.MobileDeviceConfigurationProfiles = (*MobileDeviceConfigurationProfilesService)(&c.common)
+	c.MobileDeviceGroups = (*MobileDeviceGroupsService)(&c.common)
+	c.MobileDevices = (*MobileDevicesService)(&c.common)
 	c.OSXConfigurationProfiles = (*OSXConfigurationProfilesService)(&c.common)
 	c.Packages = (*PackagesService)(&c.common)
+	c.PatchPolicies = (*PatchPoliciesService)(&c.common)
+	c.PatchSoftwareTitles = (*PatchSoftwareTitlesService)(&c.common)
 	c.Policies = (*PoliciesService)(&c.common)
 
 	return c, nil
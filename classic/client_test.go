@@ -8,11 +8,14 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
 	"github.com/kenchan0130/go-jamf-pro/jamfproapi"
 )
 
@@ -36,14 +39,121 @@ func setup() (
 
 	server := httptest.NewServer(apiHandler)
 
-	c, _ := jamfproapi.NewClient(server.URL)
-	authToken, _, _ := c.APIAuthentication.Token(context.Background(), "dummyUsername", "dummyPassword")
-
 	client, _ = NewClient(server.URL)
-	client.AuthorizationToken = authToken.Token
+	client.TokenSource, _ = jamfproapi.NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
 
 	return client, mux, server.URL, server.Close
 }
+
+func TestNewClient_WithTokenSource(t *testing.T) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&issued, 1)
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "shared-token",
+			"expires": "%s"
+		}`, time.Now().Add(time.Minute*30).Format(time.RFC3339)))))
+	})
+	testAuthorization := func(r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer shared-token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer shared-token\"", got)
+		}
+	}
+	mux.HandleFunc(buildHandlePath(computerGroupsPath), func(w http.ResponseWriter, r *http.Request) {
+		testAuthorization(r)
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><computer_groups><size>0</size></computer_groups>`))
+	})
+	mux.HandleFunc("/api/v1/sso/failover", func(w http.ResponseWriter, r *http.Request) {
+		testAuthorization(r)
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	authorizer, err := jamfproapi.NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
+	if err != nil {
+		t.Fatalf("jamfproapi.NewBasicAuthTokenSource(): %v", err)
+	}
+
+	classicClient, _ := NewClient(server.URL, jamf.WithTokenSource(authorizer))
+	proClient, _ := jamfproapi.NewClient(server.URL, jamf.WithTokenSource(authorizer))
+
+	ctx := context.Background()
+	if _, _, err := classicClient.ComputerGroups.List(ctx); err != nil {
+		t.Fatalf("ComputerGroups.List(): %v", err)
+	}
+	if _, _, err := proClient.SSOFailover.Get(ctx); err != nil {
+		t.Fatalf("SSOFailover.Get(): %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&issued), int32(1); got != want {
+		t.Errorf("token was issued %d times, want %d", got, want)
+	}
+}
+
+func TestNewClient_WithAuthorizer(t *testing.T) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "dummyUsername" || password != "dummyPassword" {
+			t.Errorf("BasicAuth() returned (%q, %q, %v), want (\"dummyUsername\", \"dummyPassword\", true)", username, password, ok)
+		}
+
+		atomic.AddInt32(&issued, 1)
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "shared-token",
+			"expires": "%s"
+		}`, time.Now().Add(time.Minute*30).Format(time.RFC3339)))))
+	})
+	mux.HandleFunc(buildHandlePath(computerGroupsPath), func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer shared-token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer shared-token\"", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><computer_groups><size>0</size></computer_groups>`))
+	})
+	mux.HandleFunc("/api/v1/sso/failover", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer shared-token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer shared-token\"", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	authorizer := &jamfproapi.Authorizer{
+		BaseURL:  serverURL,
+		Username: "dummyUsername",
+		Password: "dummyPassword",
+	}
+
+	classicClient, _ := NewClient(server.URL, jamf.WithAuthorizer(authorizer))
+	proClient, _ := jamfproapi.NewClient(server.URL, jamf.WithAuthorizer(authorizer))
+
+	ctx := context.Background()
+	if _, _, err := classicClient.ComputerGroups.List(ctx); err != nil {
+		t.Fatalf("ComputerGroups.List(): %v", err)
+	}
+	if _, _, err := proClient.SSOFailover.Get(ctx); err != nil {
+		t.Fatalf("SSOFailover.Get(): %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&issued), int32(1); got != want {
+		t.Errorf("token was issued %d times, want %d", got, want)
+	}
+}
+
 func testMethod(t *testing.T, r *http.Request, want string) {
 	t.Helper()
 	if got := r.Method; got != want {
@@ -0,0 +1,47 @@
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+)
+
+type CommandFlushService service
+
+type CommandFlushIDType string
+
+const (
+	CommandFlushIDTypeComputers          CommandFlushIDType = "computers"
+	CommandFlushIDTypeComputerGroups     CommandFlushIDType = "computergroups"
+	CommandFlushIDTypeMobileDevices      CommandFlushIDType = "mobiledevices"
+	CommandFlushIDTypeMobileDeviceGroups CommandFlushIDType = "mobiledevicegroups"
+)
+
+type CommandFlushStatus string
+
+const (
+	CommandFlushStatusPending          CommandFlushStatus = "Pending"
+	CommandFlushStatusFailed           CommandFlushStatus = "Failed"
+	CommandFlushStatusPendingAndFailed CommandFlushStatus = "Pending+Failed"
+)
+
+const commandFlushPath = "/commandflush"
+
+// Flush cancels the commands in the status which are queued for the computer, the mobile device or the members of the group.
+func (s *CommandFlushService) Flush(ctx context.Context, idType CommandFlushIDType, id int, status CommandFlushStatus) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(commandFlushPath, string(idType), "id", fmt.Sprint(id), "status", string(status)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,24 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCommandFlushService_Flush(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(commandFlushPath, "mobiledevices", "id", "1", "status", "Pending+Failed"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.CommandFlush.Flush(ctx, CommandFlushIDTypeMobileDevices, 1, CommandFlushStatusPendingAndFailed)
+	if err != nil {
+		t.Errorf("CommandFlush.Flush(): %v", err)
+	}
+}
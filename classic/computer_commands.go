@@ -0,0 +1,198 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type ComputerCommandsService service
+
+type ListComputerCommands struct {
+	Size             *int                   `xml:"size,omitempty"`
+	ComputerCommands *[]ListComputerCommand `xml:"computer_command,omitempty"`
+}
+
+type ListComputerCommand struct {
+	ID      *int    `xml:"id,omitempty"`
+	Name    *string `xml:"name,omitempty"`
+	Command *string `xml:"command,omitempty"`
+	UUID    *string `xml:"uuid,omitempty"`
+}
+
+type ComputerCommand struct {
+	General   *ComputerCommandGeneral    `xml:"general,omitempty"`
+	Computers *[]ComputerCommandComputer `xml:"computers>computer,omitempty"`
+}
+
+type ComputerCommandGeneral struct {
+	Command *ComputerCommandName `xml:"command,omitempty"`
+	// DeviceLock and EraseDevice
+	Passcode *string `xml:"passcode,omitempty"`
+	// DeleteUser and UnlockUserAccount
+	UserName *string `xml:"user_name,omitempty"`
+	// ScheduleOSUpdate
+	Action         *string `xml:"action,omitempty"`
+	ProductVersion *string `xml:"product_version,omitempty"`
+}
+
+type ComputerCommandName string
+
+const (
+	ComputerCommandNameBlankPush                ComputerCommandName = "BlankPush"
+	ComputerCommandNameDeleteUser               ComputerCommandName = "DeleteUser"
+	ComputerCommandNameDeviceLock               ComputerCommandName = "DeviceLock"
+	ComputerCommandNameDisableRemoteDesktop     ComputerCommandName = "DisableRemoteDesktop"
+	ComputerCommandNameEnableRemoteDesktop      ComputerCommandName = "EnableRemoteDesktop"
+	ComputerCommandNameEraseDevice              ComputerCommandName = "EraseDevice"
+	ComputerCommandNameScheduleOSUpdate         ComputerCommandName = "ScheduleOSUpdate"
+	ComputerCommandNameSettingsDisableBluetooth ComputerCommandName = "SettingsDisableBluetooth"
+	ComputerCommandNameSettingsEnableBluetooth  ComputerCommandName = "SettingsEnableBluetooth"
+	ComputerCommandNameUnlockUserAccount        ComputerCommandName = "UnlockUserAccount"
+	ComputerCommandNameUnmanageDevice           ComputerCommandName = "UnmanageDevice"
+)
+
+type ComputerCommandComputer struct {
+	ID *int `xml:"id,omitempty"`
+}
+
+// ComputerCommandResult is a command which is sent to a computer.
+type ComputerCommandResult struct {
+	Name        *string `xml:"name,omitempty"`
+	CommandUUID *string `xml:"command_uuid,omitempty"`
+	ComputerID  *int    `xml:"computer_id,omitempty"`
+}
+
+type ComputerCommandStatus struct {
+	ComputerID         *int    `xml:"computer_id,omitempty"`
+	CommandUUID        *string `xml:"command_uuid,omitempty"`
+	Command            *string `xml:"command,omitempty"`
+	Status             *string `xml:"status,omitempty"`
+	DateSent           *string `xml:"date_sent,omitempty"`
+	DateSentEpoch      *int64  `xml:"date_sent_epoch,omitempty"`
+	DateSentUTC        *string `xml:"date_sent_utc,omitempty"`
+	DateCompleted      *string `xml:"date_completed,omitempty"`
+	DateCompletedEpoch *int64  `xml:"date_completed_epoch,omitempty"`
+	DateCompletedUTC   *string `xml:"date_completed_utc,omitempty"`
+}
+
+const computerCommandsPath = "/computercommands"
+
+// Create sends the command to the computers and returns the commands, one for each computer.
+func (s *ComputerCommandsService) Create(ctx context.Context, computerCommand *ComputerCommand) ([]ComputerCommandResult, *jamf.Response, error) {
+	if computerCommand == nil {
+		return nil, nil, errors.New("ComputerCommandsService.Create(): cannot create nil computer command")
+	}
+	if computerCommand.General == nil || computerCommand.General.Command == nil {
+		return nil, nil, errors.New("ComputerCommandsService.Create(): cannot create computer command with nil Command of General")
+	}
+	if computerCommand.Computers == nil || len(*computerCommand.Computers) == 0 {
+		return nil, nil, errors.New("ComputerCommandsService.Create(): cannot create computer command with empty Computers")
+	}
+
+	reqBody := &struct {
+		*ComputerCommand
+		XMLName xml.Name `xml:"computer_command"`
+	}{
+		ComputerCommand: computerCommand,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: path.Join(computerCommandsPath, "command", string(*computerCommand.General.Command)),
+			Route:  path.Join(computerCommandsPath, "command", "{command}"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName  xml.Name                `xml:"computer_command"`
+		Commands []ComputerCommandResult `xml:"command"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return data.Commands, resp, nil
+}
+
+// GetStatus returns the status of the command which is sent to a computer.
+// A 404 is retried as a consistency failure, since a command just sent may not be found yet,
+// so an unknown UUID is reported as a 404 only after the retries of the RetryPolicy run out.
+func (s *ComputerCommandsService) GetStatus(ctx context.Context, commandUUID string) (*ComputerCommandStatus, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(computerCommandsPath, "status", commandUUID),
+			Route:  path.Join(computerCommandsPath, "status", "{uuid}"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var computerCommandStatus ComputerCommandStatus
+	if err := xml.Unmarshal(respBody, &computerCommandStatus); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &computerCommandStatus, resp, nil
+}
+
+func (s *ComputerCommandsService) List(ctx context.Context) (*ListComputerCommands, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: computerCommandsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listComputerCommands ListComputerCommands
+	if err := xml.Unmarshal(respBody, &listComputerCommands); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listComputerCommands, resp, nil
+}
@@ -0,0 +1,147 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputerCommandsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computerCommandsPath, "command", "DeviceLock"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<computer_command><general><command>DeviceLock</command><passcode>123456</passcode></general><computers><computer><id>1</id></computer><computer><id>2</id></computer></computers></computer_command>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer_command>
+  <command>
+    <name>DeviceLock</name>
+    <command_uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</command_uuid>
+    <computer_id>1</computer_id>
+  </command>
+  <command>
+    <name>DeviceLock</name>
+    <command_uuid>bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937</command_uuid>
+    <computer_id>2</computer_id>
+  </command>
+</computer_command>`))
+	})
+
+	ctx := context.Background()
+	commands, _, err := client.ComputerCommands.Create(ctx, &ComputerCommand{
+		General: &ComputerCommandGeneral{
+			Command:  ptr(ComputerCommandNameDeviceLock),
+			Passcode: ptr("123456"),
+		},
+		Computers: &[]ComputerCommandComputer{
+			{ID: ptr(1)},
+			{ID: ptr(2)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ComputerCommands.Create(): %v", err)
+	}
+
+	want := []ComputerCommandResult{
+		{
+			Name:        ptr("DeviceLock"),
+			CommandUUID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+			ComputerID:  ptr(1),
+		},
+		{
+			Name:        ptr("DeviceLock"),
+			CommandUUID: ptr("bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937"),
+			ComputerID:  ptr(2),
+		},
+	}
+	if !cmp.Equal(commands, want) {
+		t.Errorf("ComputerCommands.Create() returned %s, want %s", formatWithSpew(commands), formatWithSpew(want))
+	}
+}
+
+func TestComputerCommandsService_GetStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computerCommandsPath, "status", "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer_command>
+  <computer_id>1</computer_id>
+  <command_uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</command_uuid>
+  <command>DeviceLock</command>
+  <status>Pending</status>
+  <date_sent>2024-04-01T10:00:00.000+0000</date_sent>
+  <date_sent_epoch>1711965600000</date_sent_epoch>
+  <date_sent_utc>2024-04-01T10:00:00.000+0000</date_sent_utc>
+</computer_command>`))
+	})
+
+	ctx := context.Background()
+	status, _, err := client.ComputerCommands.GetStatus(ctx, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")
+	if err != nil {
+		t.Fatalf("ComputerCommands.GetStatus(): %v", err)
+	}
+
+	want := &ComputerCommandStatus{
+		ComputerID:    ptr(1),
+		CommandUUID:   ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		Command:       ptr("DeviceLock"),
+		Status:        ptr("Pending"),
+		DateSent:      ptr("2024-04-01T10:00:00.000+0000"),
+		DateSentEpoch: ptr(int64(1711965600000)),
+		DateSentUTC:   ptr("2024-04-01T10:00:00.000+0000"),
+	}
+	if !cmp.Equal(status, want) {
+		t.Errorf("ComputerCommands.GetStatus() returned %s, want %s", formatWithSpew(status), formatWithSpew(want))
+	}
+}
+
+func TestComputerCommandsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computerCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer_commands>
+  <size>1</size>
+  <computer_command>
+    <id>1</id>
+    <name>DeviceLock</name>
+    <command>DeviceLock</command>
+    <uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</uuid>
+  </computer_command>
+</computer_commands>`))
+	})
+
+	ctx := context.Background()
+	computerCommands, _, err := client.ComputerCommands.List(ctx)
+	if err != nil {
+		t.Fatalf("ComputerCommands.List(): %v", err)
+	}
+
+	want := &ListComputerCommands{
+		Size: ptr(1),
+		ComputerCommands: &[]ListComputerCommand{
+			{
+				ID:      ptr(1),
+				Name:    ptr("DeviceLock"),
+				Command: ptr("DeviceLock"),
+				UUID:    ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+			},
+		},
+	}
+	if !cmp.Equal(computerCommands, want) {
+		t.Errorf("ComputerCommands.List() returned %s, want %s", formatWithSpew(computerCommands), formatWithSpew(want))
+	}
+}
@@ -100,7 +100,7 @@ func (s *ComputerExtensionAttributesService) Create(ctx context.Context, compute
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -113,13 +113,13 @@ func (s *ComputerExtensionAttributesService) Create(ctx context.Context, compute
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -127,7 +127,7 @@ func (s *ComputerExtensionAttributesService) Create(ctx context.Context, compute
 		ID      *int     `xml:"id"`
 	}
 	if err := xml.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	// The create API only returns the ID of the new ComputerExtensionAttribute.
@@ -145,7 +145,7 @@ func (s *ComputerExtensionAttributesService) Delete(ctx context.Context, compute
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -162,18 +162,18 @@ func (s *ComputerExtensionAttributesService) Get(ctx context.Context, computerEx
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var computerExtensionAttribute ComputerExtensionAttribute
 	if err := xml.Unmarshal(respBody, &computerExtensionAttribute); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &computerExtensionAttribute, resp, nil
@@ -188,18 +188,18 @@ func (s *ComputerExtensionAttributesService) List(ctx context.Context) (*ListCom
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listComputerExtensionAttributes ListComputerExtensionAttributes
 	if err := xml.Unmarshal(respBody, &listComputerExtensionAttributes); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &listComputerExtensionAttributes, resp, nil
@@ -221,7 +221,7 @@ func (s *ComputerExtensionAttributesService) Update(ctx context.Context, compute
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -235,7 +235,7 @@ func (s *ComputerExtensionAttributesService) Update(ctx context.Context, compute
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, fmt.Errorf("client.Put(): %w", err)
 	}
 
 	return resp, nil
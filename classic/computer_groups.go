@@ -113,7 +113,7 @@ func (s *ComputerGroupsService) Create(ctx context.Context, computerGroup *Compu
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -126,13 +126,13 @@ func (s *ComputerGroupsService) Create(ctx context.Context, computerGroup *Compu
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -140,7 +140,7 @@ func (s *ComputerGroupsService) Create(ctx context.Context, computerGroup *Compu
 		ID      *int     `xml:"id"`
 	}
 	if err := xml.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	// The create API only returns the ID of the new ComputerGroup.
@@ -158,7 +158,7 @@ func (s *ComputerGroupsService) Delete(ctx context.Context, computerGroupID int)
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -175,18 +175,18 @@ func (s *ComputerGroupsService) Get(ctx context.Context, computerGroupID int) (*
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var computerGroup ComputerGroup
 	if err := xml.Unmarshal(respBody, &computerGroup); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &computerGroup, resp, nil
@@ -201,18 +201,18 @@ func (s *ComputerGroupsService) List(ctx context.Context) (*ListComputerGroups,
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listComputerGroups ListComputerGroups
 	if err := xml.Unmarshal(respBody, &listComputerGroups); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &listComputerGroups, resp, nil
@@ -237,7 +237,7 @@ func (s *ComputerGroupsService) Update(ctx context.Context, computerGroup *Compu
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -251,7 +251,7 @@ func (s *ComputerGroupsService) Update(ctx context.Context, computerGroup *Compu
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, fmt.Errorf("client.Put(): %w", err)
 	}
 
 	return resp, nil
@@ -0,0 +1,603 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type ComputersService service
+
+type ListComputers struct {
+	Size      *int            `xml:"size,omitempty"`
+	Computers *[]ListComputer `xml:"computer,omitempty"`
+}
+
+type ListComputer struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MatchComputers struct {
+	Size      *int             `xml:"size,omitempty"`
+	Computers *[]MatchComputer `xml:"computer,omitempty"`
+}
+
+type MatchComputer struct {
+	ID             *int    `xml:"id,omitempty"`
+	Name           *string `xml:"name,omitempty"`
+	UDID           *string `xml:"udid,omitempty"`
+	SerialNumber   *string `xml:"serial_number,omitempty"`
+	MacAddress     *string `xml:"mac_address,omitempty"`
+	AltMacAddress  *string `xml:"alt_mac_address,omitempty"`
+	AssetTag       *string `xml:"asset_tag,omitempty"`
+	BarCode1       *string `xml:"bar_code_1,omitempty"`
+	BarCode2       *string `xml:"bar_code_2,omitempty"`
+	Username       *string `xml:"username,omitempty"`
+	Realname       *string `xml:"realname,omitempty"`
+	Email          *string `xml:"email,omitempty"`
+	EmailAddress   *string `xml:"email_address,omitempty"`
+	Room           *string `xml:"room,omitempty"`
+	Position       *string `xml:"position,omitempty"`
+	Building       *string `xml:"building,omitempty"`
+	BuildingName   *string `xml:"building_name,omitempty"`
+	Department     *string `xml:"department,omitempty"`
+	DepartmentName *string `xml:"department_name,omitempty"`
+}
+
+type Computer struct {
+	General               *ComputerGeneral               `xml:"general,omitempty"`
+	Location              *ComputerLocation              `xml:"location,omitempty"`
+	Purchasing            *ComputerPurchasing            `xml:"purchasing,omitempty"`
+	Peripherals           *ComputerPeripherals           `xml:"peripherals,omitempty"`
+	Hardware              *ComputerHardware              `xml:"hardware,omitempty"`
+	Certificates          *ComputerCertificates          `xml:"certificates,omitempty"`
+	Security              *ComputerSecurity              `xml:"security,omitempty"`
+	Software              *ComputerSoftware              `xml:"software,omitempty"`
+	ExtensionAttributes   *ComputerExtensionAttributes   `xml:"extension_attributes,omitempty"`
+	GroupsAccounts        *ComputerGroupsAccounts        `xml:"groups_accounts,omitempty"`
+	ConfigurationProfiles *ComputerConfigurationProfiles `xml:"configuration_profiles,omitempty"`
+}
+
+type ComputerGeneral struct {
+	ID                         *int                             `xml:"id,omitempty"`
+	Name                       *string                          `xml:"name,omitempty"`
+	NetworkAdapterType         *string                          `xml:"network_adapter_type,omitempty"`
+	MacAddress                 *string                          `xml:"mac_address,omitempty"`
+	AltNetworkAdapterType      *string                          `xml:"alt_network_adapter_type,omitempty"`
+	AltMacAddress              *string                          `xml:"alt_mac_address,omitempty"`
+	IPAddress                  *string                          `xml:"ip_address,omitempty"`
+	LastReportedIP             *string                          `xml:"last_reported_ip,omitempty"`
+	SerialNumber               *string                          `xml:"serial_number,omitempty"`
+	UDID                       *string                          `xml:"udid,omitempty"`
+	JamfVersion                *string                          `xml:"jamf_version,omitempty"`
+	Platform                   *string                          `xml:"platform,omitempty"`
+	Barcode1                   *string                          `xml:"barcode_1,omitempty"`
+	Barcode2                   *string                          `xml:"barcode_2,omitempty"`
+	AssetTag                   *string                          `xml:"asset_tag,omitempty"`
+	RemoteManagement           *ComputerGeneralRemoteManagement `xml:"remote_management,omitempty"`
+	Supervised                 *bool                            `xml:"supervised,omitempty"`
+	MDMCapable                 *bool                            `xml:"mdm_capable,omitempty"`
+	MDMCapableUsers            *[]string                        `xml:"mdm_capable_users>mdm_capable_user,omitempty"`
+	ManagementStatus           *ComputerGeneralManagementStatus `xml:"management_status,omitempty"`
+	ReportDate                 *string                          `xml:"report_date,omitempty"`
+	ReportDateEpoch            *int64                           `xml:"report_date_epoch,omitempty"`
+	ReportDateUTC              *string                          `xml:"report_date_utc,omitempty"`
+	LastContactTime            *string                          `xml:"last_contact_time,omitempty"`
+	LastContactTimeEpoch       *int64                           `xml:"last_contact_time_epoch,omitempty"`
+	LastContactTimeUTC         *string                          `xml:"last_contact_time_utc,omitempty"`
+	InitialEntryDate           *string                          `xml:"initial_entry_date,omitempty"`
+	InitialEntryDateEpoch      *int64                           `xml:"initial_entry_date_epoch,omitempty"`
+	InitialEntryDateUTC        *string                          `xml:"initial_entry_date_utc,omitempty"`
+	LastCloudBackupDateEpoch   *int64                           `xml:"last_cloud_backup_date_epoch,omitempty"`
+	LastCloudBackupDateUTC     *string                          `xml:"last_cloud_backup_date_utc,omitempty"`
+	LastEnrolledDateEpoch      *int64                           `xml:"last_enrolled_date_epoch,omitempty"`
+	LastEnrolledDateUTC        *string                          `xml:"last_enrolled_date_utc,omitempty"`
+	MDMProfileExpirationEpoch  *int64                           `xml:"mdm_profile_expiration_epoch,omitempty"`
+	MDMProfileExpirationUTC    *string                          `xml:"mdm_profile_expiration_utc,omitempty"`
+	DistributionPoint          *string                          `xml:"distribution_point,omitempty"`
+	SUS                        *string                          `xml:"sus,omitempty"`
+	Site                       *Site                            `xml:"site,omitempty"`
+	ITunesStoreAccountIsActive *bool                            `xml:"itunes_store_account_is_active,omitempty"`
+}
+
+type ComputerGeneralRemoteManagement struct {
+	Managed                  *bool   `xml:"managed,omitempty"`
+	ManagementUsername       *string `xml:"management_username,omitempty"`
+	ManagementPasswordSHA256 *string `xml:"management_password_sha256,omitempty"`
+}
+
+type ComputerGeneralManagementStatus struct {
+	EnrolledViaDEP         *bool `xml:"enrolled_via_dep,omitempty"`
+	UserApprovedEnrollment *bool `xml:"user_approved_enrollment,omitempty"`
+	UserApprovedMDM        *bool `xml:"user_approved_mdm,omitempty"`
+}
+
+type ComputerLocation struct {
+	Username     *string `xml:"username,omitempty"`
+	Realname     *string `xml:"realname,omitempty"`
+	RealName     *string `xml:"real_name,omitempty"`
+	EmailAddress *string `xml:"email_address,omitempty"`
+	Position     *string `xml:"position,omitempty"`
+	Phone        *string `xml:"phone,omitempty"`
+	PhoneNumber  *string `xml:"phone_number,omitempty"`
+	Department   *string `xml:"department,omitempty"`
+	Building     *string `xml:"building,omitempty"`
+	Room         *string `xml:"room,omitempty"`
+}
+
+type ComputerPurchasing struct {
+	IsPurchased          *bool                           `xml:"is_purchased,omitempty"`
+	IsLeased             *bool                           `xml:"is_leased,omitempty"`
+	PONumber             *string                         `xml:"po_number,omitempty"`
+	Vendor               *string                         `xml:"vendor,omitempty"`
+	AppleCareID          *string                         `xml:"applecare_id,omitempty"`
+	PurchasePrice        *string                         `xml:"purchase_price,omitempty"`
+	PurchasingAccount    *string                         `xml:"purchasing_account,omitempty"`
+	PODate               *string                         `xml:"po_date,omitempty"`
+	PODateEpoch          *int64                          `xml:"po_date_epoch,omitempty"`
+	PODateUTC            *string                         `xml:"po_date_utc,omitempty"`
+	WarrantyExpires      *string                         `xml:"warranty_expires,omitempty"`
+	WarrantyExpiresEpoch *int64                          `xml:"warranty_expires_epoch,omitempty"`
+	WarrantyExpiresUTC   *string                         `xml:"warranty_expires_utc,omitempty"`
+	LeaseExpires         *string                         `xml:"lease_expires,omitempty"`
+	LeaseExpiresEpoch    *int64                          `xml:"lease_expires_epoch,omitempty"`
+	LeaseExpiresUTC      *string                         `xml:"lease_expires_utc,omitempty"`
+	LifeExpectancy       *int                            `xml:"life_expectancy,omitempty"`
+	PurchasingContact    *string                         `xml:"purchasing_contact,omitempty"`
+	OSAppleCareID        *string                         `xml:"os_applecare_id,omitempty"`
+	OSMaintenanceExpires *string                         `xml:"os_maintenance_expires,omitempty"`
+	Attachments          *[]ComputerPurchasingAttachment `xml:"attachments>attachment,omitempty"`
+}
+
+type ComputerPurchasingAttachment struct {
+	ID       *int    `xml:"id,omitempty"`
+	Filename *string `xml:"filename,omitempty"`
+	URI      *string `xml:"uri,omitempty"`
+}
+
+type ComputerPeripherals struct {
+	Size        *int                  `xml:"size,omitempty"`
+	Peripherals *[]ComputerPeripheral `xml:"peripheral,omitempty"`
+}
+
+type ComputerPeripheral struct {
+	ID       *int                       `xml:"id,omitempty"`
+	BarCode1 *string                    `xml:"bar_code_1,omitempty"`
+	BarCode2 *string                    `xml:"bar_code_2,omitempty"`
+	Type     *string                    `xml:"type,omitempty"`
+	Fields   *[]ComputerPeripheralField `xml:"fields>field,omitempty"`
+}
+
+type ComputerPeripheralField struct {
+	Name  *string `xml:"name,omitempty"`
+	Value *string `xml:"value,omitempty"`
+}
+
+type ComputerHardware struct {
+	Make                        *string                          `xml:"make,omitempty"`
+	Model                       *string                          `xml:"model,omitempty"`
+	ModelIdentifier             *string                          `xml:"model_identifier,omitempty"`
+	OSName                      *string                          `xml:"os_name,omitempty"`
+	OSVersion                   *string                          `xml:"os_version,omitempty"`
+	OSBuild                     *string                          `xml:"os_build,omitempty"`
+	SoftwareUpdateDeviceID      *string                          `xml:"software_update_device_id,omitempty"`
+	ActiveDirectoryStatus       *string                          `xml:"active_directory_status,omitempty"`
+	ServicePack                 *string                          `xml:"service_pack,omitempty"`
+	ProcessorType               *string                          `xml:"processor_type,omitempty"`
+	IsAppleSilicon              *bool                            `xml:"is_apple_silicon,omitempty"`
+	ProcessorArchitecture       *string                          `xml:"processor_architecture,omitempty"`
+	ProcessorSpeed              *int                             `xml:"processor_speed,omitempty"`
+	ProcessorSpeedMHz           *int                             `xml:"processor_speed_mhz,omitempty"`
+	NumberProcessors            *int                             `xml:"number_processors,omitempty"`
+	NumberCores                 *int                             `xml:"number_cores,omitempty"`
+	TotalRAM                    *int                             `xml:"total_ram,omitempty"`
+	TotalRAMMB                  *int                             `xml:"total_ram_mb,omitempty"`
+	BootROM                     *string                          `xml:"boot_rom,omitempty"`
+	BusSpeed                    *int                             `xml:"bus_speed,omitempty"`
+	BusSpeedMHz                 *int                             `xml:"bus_speed_mhz,omitempty"`
+	BatteryCapacity             *int                             `xml:"battery_capacity,omitempty"`
+	CacheSize                   *int                             `xml:"cache_size,omitempty"`
+	CacheSizeKB                 *int                             `xml:"cache_size_kb,omitempty"`
+	AvailableRAMSlots           *int                             `xml:"available_ram_slots,omitempty"`
+	OpticalDrive                *string                          `xml:"optical_drive,omitempty"`
+	NICSpeed                    *string                          `xml:"nic_speed,omitempty"`
+	SMCVersion                  *string                          `xml:"smc_version,omitempty"`
+	BLECapable                  *bool                            `xml:"ble_capable,omitempty"`
+	SupportsIOSAppInstalls      *bool                            `xml:"supports_ios_app_installs,omitempty"`
+	SIPStatus                   *string                          `xml:"sip_status,omitempty"`
+	GatekeeperStatus            *string                          `xml:"gatekeeper_status,omitempty"`
+	XProtectVersion             *string                          `xml:"xprotect_version,omitempty"`
+	InstitutionalRecoveryKey    *string                          `xml:"institutional_recovery_key,omitempty"`
+	DiskEncryptionConfiguration *string                          `xml:"disk_encryption_configuration,omitempty"`
+	FileVault2Users             *[]string                        `xml:"filevault2_users>user,omitempty"`
+	Storage                     *[]ComputerHardwareStorageDevice `xml:"storage>device,omitempty"`
+	MappedPrinters              *[]ComputerHardwareMappedPrinter `xml:"mapped_printers>printer,omitempty"`
+}
+
+type ComputerHardwareStorageDevice struct {
+	Disk            *string                                   `xml:"disk,omitempty"`
+	Model           *string                                   `xml:"model,omitempty"`
+	Revision        *string                                   `xml:"revision,omitempty"`
+	SerialNumber    *string                                   `xml:"serial_number,omitempty"`
+	Size            *int                                      `xml:"size,omitempty"`
+	DriveCapacityMB *int                                      `xml:"drive_capacity_mb,omitempty"`
+	ConnectionType  *string                                   `xml:"connection_type,omitempty"`
+	SMARTStatus     *string                                   `xml:"smart_status,omitempty"`
+	Partitions      *[]ComputerHardwareStorageDevicePartition `xml:"partition,omitempty"`
+}
+
+type ComputerHardwareStorageDevicePartition struct {
+	Name                 *string `xml:"name,omitempty"`
+	Size                 *int    `xml:"size,omitempty"`
+	Type                 *string `xml:"type,omitempty"`
+	PartitionCapacityMB  *int    `xml:"partition_capacity_mb,omitempty"`
+	PercentageFull       *int    `xml:"percentage_full,omitempty"`
+	AvailableMB          *int    `xml:"available_mb,omitempty"`
+	FileVaultStatus      *string `xml:"filevault_status,omitempty"`
+	FileVaultPercent     *int    `xml:"filevault_percent,omitempty"`
+	FileVault2Status     *string `xml:"filevault2_status,omitempty"`
+	FileVault2Percent    *int    `xml:"filevault2_percent,omitempty"`
+	BootDriveAvailableMB *int    `xml:"boot_drive_available_mb,omitempty"`
+	LVGUUID              *string `xml:"lvgUUID,omitempty"`
+	LVUUID               *string `xml:"lvUUID,omitempty"`
+	PVUUID               *string `xml:"pvUUID,omitempty"`
+}
+
+type ComputerHardwareMappedPrinter struct {
+	Name     *string `xml:"name,omitempty"`
+	URI      *string `xml:"uri,omitempty"`
+	Type     *string `xml:"type,omitempty"`
+	Location *string `xml:"location,omitempty"`
+}
+
+type ComputerCertificates struct {
+	Size         *int                   `xml:"size,omitempty"`
+	Certificates *[]ComputerCertificate `xml:"certificate,omitempty"`
+}
+
+type ComputerCertificate struct {
+	CommonName   *string `xml:"common_name,omitempty"`
+	Identity     *bool   `xml:"identity,omitempty"`
+	ExpiresUTC   *string `xml:"expires_utc,omitempty"`
+	ExpiresEpoch *int64  `xml:"expires_epoch,omitempty"`
+	Name         *string `xml:"name,omitempty"`
+}
+
+type ComputerSecurity struct {
+	ActivationLock      *bool   `xml:"activation_lock,omitempty"`
+	RecoveryLockEnabled *bool   `xml:"recovery_lock_enabled,omitempty"`
+	SecureBootLevel     *string `xml:"secure_boot_level,omitempty"`
+	ExternalBootLevel   *string `xml:"external_boot_level,omitempty"`
+	FirewallEnabled     *bool   `xml:"firewall_enabled,omitempty"`
+}
+
+type ComputerSoftware struct {
+	UnixExecutables          *[]string                          `xml:"unix_executables>string,omitempty"`
+	LicensedSoftware         *[]string                          `xml:"licensed_software>name,omitempty"`
+	InstalledByCasper        *[]string                          `xml:"installed_by_casper>package,omitempty"`
+	InstalledByInstallerSWU  *[]string                          `xml:"installed_by_installer_swu>package,omitempty"`
+	CachedByCasper           *[]string                          `xml:"cached_by_casper>package,omitempty"`
+	AvailableSoftwareUpdates *[]string                          `xml:"available_software_updates>name,omitempty"`
+	AvailableUpdates         *[]ComputerSoftwareAvailableUpdate `xml:"available_updates>update,omitempty"`
+	RunningServices          *[]string                          `xml:"running_services>name,omitempty"`
+	Applications             *ComputerSoftwareApplications      `xml:"applications,omitempty"`
+	Fonts                    *ComputerSoftwareFonts             `xml:"fonts,omitempty"`
+	Plugins                  *ComputerSoftwarePlugins           `xml:"plugins,omitempty"`
+}
+
+type ComputerSoftwareAvailableUpdate struct {
+	Name        *string `xml:"name,omitempty"`
+	PackageName *string `xml:"package_name,omitempty"`
+	Version     *string `xml:"version,omitempty"`
+}
+
+type ComputerSoftwareApplications struct {
+	Size         *int                           `xml:"size,omitempty"`
+	Applications *[]ComputerSoftwareApplication `xml:"application,omitempty"`
+}
+
+type ComputerSoftwareApplication struct {
+	Name     *string `xml:"name,omitempty"`
+	Path     *string `xml:"path,omitempty"`
+	Version  *string `xml:"version,omitempty"`
+	BundleID *string `xml:"bundle_id,omitempty"`
+}
+
+type ComputerSoftwareFonts struct {
+	Size  *int                    `xml:"size,omitempty"`
+	Fonts *[]ComputerSoftwareFont `xml:"font,omitempty"`
+}
+
+type ComputerSoftwareFont struct {
+	Name    *string `xml:"name,omitempty"`
+	Path    *string `xml:"path,omitempty"`
+	Version *string `xml:"version,omitempty"`
+}
+
+type ComputerSoftwarePlugins struct {
+	Size    *int                      `xml:"size,omitempty"`
+	Plugins *[]ComputerSoftwarePlugin `xml:"plugin,omitempty"`
+}
+
+type ComputerSoftwarePlugin struct {
+	Name    *string `xml:"name,omitempty"`
+	Path    *string `xml:"path,omitempty"`
+	Version *string `xml:"version,omitempty"`
+}
+
+type ComputerExtensionAttributes struct {
+	ExtensionAttributes *[]ComputerExtensionAttributeValue `xml:"extension_attribute,omitempty"`
+}
+
+// ComputerExtensionAttributeValue is the value of an extension attribute of a computer.
+// Only ID and Value are required to update it.
+type ComputerExtensionAttributeValue struct {
+	ID         *int    `xml:"id,omitempty"`
+	Name       *string `xml:"name,omitempty"`
+	Type       *string `xml:"type,omitempty"`
+	MultiValue *bool   `xml:"multi_value,omitempty"`
+	Value      *string `xml:"value,omitempty"`
+}
+
+type ComputerGroupsAccounts struct {
+	ComputerGroupMemberships *[]string                              `xml:"computer_group_memberships>group,omitempty"`
+	LocalAccounts            *[]ComputerGroupsAccountsLocalAccount  `xml:"local_accounts>user,omitempty"`
+	UserInventories          *ComputerGroupsAccountsUserInventories `xml:"user_inventories,omitempty"`
+}
+
+type ComputerGroupsAccountsLocalAccount struct {
+	Name             *string `xml:"name,omitempty"`
+	Realname         *string `xml:"realname,omitempty"`
+	UID              *string `xml:"uid,omitempty"`
+	Home             *string `xml:"home,omitempty"`
+	HomeSize         *string `xml:"home_size,omitempty"`
+	HomeSizeMB       *int    `xml:"home_size_mb,omitempty"`
+	Administrator    *bool   `xml:"administrator,omitempty"`
+	FileVaultEnabled *bool   `xml:"filevault_enabled,omitempty"`
+}
+
+type ComputerGroupsAccountsUserInventories struct {
+	DisableAutomaticLogin *bool                                  `xml:"disable_automatic_login,omitempty"`
+	Users                 *[]ComputerGroupsAccountsUserInventory `xml:"user,omitempty"`
+}
+
+type ComputerGroupsAccountsUserInventory struct {
+	Username                     *string `xml:"username,omitempty"`
+	PasswordHistoryDepth         *string `xml:"password_history_depth,omitempty"`
+	PasswordMinLength            *string `xml:"password_min_length,omitempty"`
+	PasswordMaxAge               *string `xml:"password_max_age,omitempty"`
+	PasswordMinComplexCharacters *string `xml:"password_min_complex_characters,omitempty"`
+	PasswordRequireAlphanumeric  *string `xml:"password_require_alphanumeric,omitempty"`
+}
+
+type ComputerConfigurationProfiles struct {
+	Size                  *int                            `xml:"size,omitempty"`
+	ConfigurationProfiles *[]ComputerConfigurationProfile `xml:"configuration_profile,omitempty"`
+}
+
+type ComputerConfigurationProfile struct {
+	ID          *int    `xml:"id,omitempty"`
+	Name        *string `xml:"name,omitempty"`
+	UUID        *string `xml:"uuid,omitempty"`
+	IsRemovable *bool   `xml:"is_removable,omitempty"`
+}
+
+// ComputerKey is the key to identify a computer in the path of the API.
+type ComputerKey string
+
+const (
+	ComputerKeyID           ComputerKey = "id"
+	ComputerKeyName         ComputerKey = "name"
+	ComputerKeyUDID         ComputerKey = "udid"
+	ComputerKeySerialNumber ComputerKey = "serialnumber"
+	ComputerKeyMacAddress   ComputerKey = "macaddress"
+)
+
+// ComputerSubset is a section of the inventory of a computer, which can be retrieved with GetSubset.
+type ComputerSubset string
+
+const (
+	ComputerSubsetGeneral               ComputerSubset = "General"
+	ComputerSubsetLocation              ComputerSubset = "Location"
+	ComputerSubsetPurchasing            ComputerSubset = "Purchasing"
+	ComputerSubsetPeripherals           ComputerSubset = "Peripherals"
+	ComputerSubsetHardware              ComputerSubset = "Hardware"
+	ComputerSubsetCertificates          ComputerSubset = "Certificates"
+	ComputerSubsetSecurity              ComputerSubset = "Security"
+	ComputerSubsetSoftware              ComputerSubset = "Software"
+	ComputerSubsetExtensionAttributes   ComputerSubset = "ExtensionAttributes"
+	ComputerSubsetGroupsAccounts        ComputerSubset = "GroupsAccounts"
+	ComputerSubsetConfigurationProfiles ComputerSubset = "ConfigurationProfiles"
+)
+
+const computersPath = "/computers"
+
+func (s *ComputersService) Delete(ctx context.Context, computerID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(computersPath, string(ComputerKeyID), fmt.Sprint(computerID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *ComputersService) Get(ctx context.Context, computerID int) (*Computer, *jamf.Response, error) {
+	return s.GetBy(ctx, ComputerKeyID, fmt.Sprint(computerID))
+}
+
+func (s *ComputersService) GetByName(ctx context.Context, name string) (*Computer, *jamf.Response, error) {
+	return s.GetBy(ctx, ComputerKeyName, name)
+}
+
+func (s *ComputersService) GetByUDID(ctx context.Context, udid string) (*Computer, *jamf.Response, error) {
+	return s.GetBy(ctx, ComputerKeyUDID, udid)
+}
+
+func (s *ComputersService) GetBySerialNumber(ctx context.Context, serialNumber string) (*Computer, *jamf.Response, error) {
+	return s.GetBy(ctx, ComputerKeySerialNumber, serialNumber)
+}
+
+func (s *ComputersService) GetByMacAddress(ctx context.Context, macAddress string) (*Computer, *jamf.Response, error) {
+	return s.GetBy(ctx, ComputerKeyMacAddress, macAddress)
+}
+
+// GetBy returns the full inventory of a computer identified by the key.
+func (s *ComputersService) GetBy(ctx context.Context, key ComputerKey, value string) (*Computer, *jamf.Response, error) {
+	return s.get(ctx, path.Join(computersPath, string(key), value))
+}
+
+// GetSubset returns only the sections of the inventory of a computer, which is much faster than the full inventory.
+func (s *ComputersService) GetSubset(ctx context.Context, key ComputerKey, value string, subsets ...ComputerSubset) (*Computer, *jamf.Response, error) {
+	if len(subsets) == 0 {
+		return nil, nil, errors.New("ComputersService.GetSubset(): cannot get computer with no subsets")
+	}
+
+	names := make([]string, len(subsets))
+	for i, subset := range subsets {
+		names[i] = string(subset)
+	}
+
+	return s.get(ctx, path.Join(computersPath, string(key), value, "subset", strings.Join(names, "&")))
+}
+
+func (s *ComputersService) get(ctx context.Context, entity string) (*Computer, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var computer Computer
+	if err := xml.Unmarshal(respBody, &computer); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &computer, resp, nil
+}
+
+func (s *ComputersService) List(ctx context.Context) (*ListComputers, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: computersPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listComputers ListComputers
+	if err := xml.Unmarshal(respBody, &listComputers); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listComputers, resp, nil
+}
+
+// Match searches computers for the term, which matches e.g. the name, the serial number and the username.
+// The term can contain '*' as a wildcard.
+func (s *ComputersService) Match(ctx context.Context, term string) (*MatchComputers, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(computersPath, "match", term),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var matchComputers MatchComputers
+	if err := xml.Unmarshal(respBody, &matchComputers); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &matchComputers, resp, nil
+}
+
+// Update updates the location, the purchasing and the extension attribute values of a computer identified by ID of General.
+// The other sections are collected from the computer by inventory, and are not sent.
+func (s *ComputersService) Update(ctx context.Context, computer *Computer) (*jamf.Response, error) {
+	if computer == nil {
+		return nil, errors.New("ComputersService.Update(): cannot update nil computer")
+	}
+	if computer.General == nil || computer.General.ID == nil {
+		return nil, errors.New("ComputersService.Update(): cannot update computer with nil ID of General")
+	}
+
+	reqBody := &struct {
+		XMLName             xml.Name                     `xml:"computer"`
+		Location            *ComputerLocation            `xml:"location,omitempty"`
+		Purchasing          *ComputerPurchasing          `xml:"purchasing,omitempty"`
+		ExtensionAttributes *ComputerExtensionAttributes `xml:"extension_attributes,omitempty"`
+	}{
+		Location:            computer.Location,
+		Purchasing:          computer.Purchasing,
+		ExtensionAttributes: computer.ExtensionAttributes,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(computersPath, string(ComputerKeyID), fmt.Sprint(*computer.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,390 @@
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputersService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := 1
+	mux.HandleFunc(buildHandlePath(computersPath, "id", fmt.Sprint(computerID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer>
+  <id>1</id>
+</computer>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.Computers.Delete(ctx, computerID)
+	if err != nil {
+		t.Errorf("Computers.Delete(): %v", err)
+	}
+}
+
+func TestComputersService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := 1
+	mux.HandleFunc(buildHandlePath(computersPath, "id", fmt.Sprint(computerID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer>
+  <general>
+    <id>1</id>
+    <name>Test Mac</name>
+    <mac_address>E0:AC:CB:97:36:G4</mac_address>
+    <serial_number>C02Q7KHTGFWF</serial_number>
+    <udid>55900BDC-347C-58B1-D249-F32244B11D30</udid>
+    <remote_management>
+      <managed>true</managed>
+      <management_username>jamfadmin</management_username>
+    </remote_management>
+    <mdm_capable>true</mdm_capable>
+    <mdm_capable_users>
+      <mdm_capable_user>admin</mdm_capable_user>
+    </mdm_capable_users>
+    <report_date_epoch>1709780400000</report_date_epoch>
+    <site>
+      <id>-1</id>
+      <name>None</name>
+    </site>
+  </general>
+  <location>
+    <username>user</username>
+    <realname>Test User</realname>
+    <email_address>user@example.com</email_address>
+  </location>
+  <hardware>
+    <model>MacBook Pro (14-inch, 2021)</model>
+    <os_version>14.4</os_version>
+    <is_apple_silicon>true</is_apple_silicon>
+    <total_ram>16384</total_ram>
+    <filevault2_users>
+      <user>admin</user>
+    </filevault2_users>
+    <storage>
+      <device>
+        <disk>disk0</disk>
+        <size>500277</size>
+        <partition>
+          <name>Macintosh HD (Boot Partition)</name>
+          <type>boot</type>
+          <percentage_full>42</percentage_full>
+          <filevault2_status>Encrypted</filevault2_status>
+        </partition>
+      </device>
+    </storage>
+  </hardware>
+  <extension_attributes>
+    <extension_attribute>
+      <id>1</id>
+      <name>Asset Owner</name>
+      <type>String</type>
+      <multi_value>false</multi_value>
+      <value>IT</value>
+    </extension_attribute>
+  </extension_attributes>
+  <groups_accounts>
+    <computer_group_memberships>
+      <group>All Managed Clients</group>
+    </computer_group_memberships>
+    <local_accounts>
+      <user>
+        <name>admin</name>
+        <uid>501</uid>
+        <administrator>true</administrator>
+      </user>
+    </local_accounts>
+  </groups_accounts>
+</computer>`))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.Computers.Get(ctx, computerID)
+	if err != nil {
+		t.Fatalf("Computers.Get(): %v", err)
+	}
+
+	want := &Computer{
+		General: &ComputerGeneral{
+			ID:           ptr(1),
+			Name:         ptr("Test Mac"),
+			MacAddress:   ptr("E0:AC:CB:97:36:G4"),
+			SerialNumber: ptr("C02Q7KHTGFWF"),
+			UDID:         ptr("55900BDC-347C-58B1-D249-F32244B11D30"),
+			RemoteManagement: &ComputerGeneralRemoteManagement{
+				Managed:            ptr(true),
+				ManagementUsername: ptr("jamfadmin"),
+			},
+			MDMCapable:      ptr(true),
+			MDMCapableUsers: &[]string{"admin"},
+			ReportDateEpoch: ptr(int64(1709780400000)),
+			Site: &Site{
+				ID:   ptr(-1),
+				Name: ptr("None"),
+			},
+		},
+		Location: &ComputerLocation{
+			Username:     ptr("user"),
+			Realname:     ptr("Test User"),
+			EmailAddress: ptr("user@example.com"),
+		},
+		Hardware: &ComputerHardware{
+			Model:           ptr("MacBook Pro (14-inch, 2021)"),
+			OSVersion:       ptr("14.4"),
+			IsAppleSilicon:  ptr(true),
+			TotalRAM:        ptr(16384),
+			FileVault2Users: &[]string{"admin"},
+			Storage: &[]ComputerHardwareStorageDevice{
+				{
+					Disk: ptr("disk0"),
+					Size: ptr(500277),
+					Partitions: &[]ComputerHardwareStorageDevicePartition{
+						{
+							Name:             ptr("Macintosh HD (Boot Partition)"),
+							Type:             ptr("boot"),
+							PercentageFull:   ptr(42),
+							FileVault2Status: ptr("Encrypted"),
+						},
+					},
+				},
+			},
+		},
+		ExtensionAttributes: &ComputerExtensionAttributes{
+			ExtensionAttributes: &[]ComputerExtensionAttributeValue{
+				{
+					ID:         ptr(1),
+					Name:       ptr("Asset Owner"),
+					Type:       ptr("String"),
+					MultiValue: ptr(false),
+					Value:      ptr("IT"),
+				},
+			},
+		},
+		GroupsAccounts: &ComputerGroupsAccounts{
+			ComputerGroupMemberships: &[]string{"All Managed Clients"},
+			LocalAccounts: &[]ComputerGroupsAccountsLocalAccount{
+				{
+					Name:          ptr("admin"),
+					UID:           ptr("501"),
+					Administrator: ptr(true),
+				},
+			},
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("Computers.Get() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
+
+func TestComputersService_GetBySerialNumber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	serialNumber := "C02Q7KHTGFWF"
+	mux.HandleFunc(buildHandlePath(computersPath, "serialnumber", serialNumber), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer>
+  <general>
+    <id>1</id>
+    <serial_number>C02Q7KHTGFWF</serial_number>
+  </general>
+</computer>`))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.Computers.GetBySerialNumber(ctx, serialNumber)
+	if err != nil {
+		t.Fatalf("Computers.GetBySerialNumber(): %v", err)
+	}
+
+	want := &Computer{
+		General: &ComputerGeneral{
+			ID:           ptr(1),
+			SerialNumber: ptr(serialNumber),
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("Computers.GetBySerialNumber() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
+
+func TestComputersService_GetSubset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computersPath, "udid", "55900BDC-347C-58B1-D249-F32244B11D30", "subset", "General&Purchasing"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computer>
+  <general>
+    <id>1</id>
+    <name>Test Mac</name>
+  </general>
+  <purchasing>
+    <is_purchased>true</is_purchased>
+    <po_number>PO-1</po_number>
+    <warranty_expires_epoch>1893456000000</warranty_expires_epoch>
+    <life_expectancy>4</life_expectancy>
+  </purchasing>
+</computer>`))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.Computers.GetSubset(ctx, ComputerKeyUDID, "55900BDC-347C-58B1-D249-F32244B11D30", ComputerSubsetGeneral, ComputerSubsetPurchasing)
+	if err != nil {
+		t.Fatalf("Computers.GetSubset(): %v", err)
+	}
+
+	want := &Computer{
+		General: &ComputerGeneral{
+			ID:   ptr(1),
+			Name: ptr("Test Mac"),
+		},
+		Purchasing: &ComputerPurchasing{
+			IsPurchased:          ptr(true),
+			PONumber:             ptr("PO-1"),
+			WarrantyExpiresEpoch: ptr(int64(1893456000000)),
+			LifeExpectancy:       ptr(4),
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("Computers.GetSubset() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
+
+func TestComputersService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computersPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computers>
+  <size>1</size>
+  <computer>
+    <id>1</id>
+    <name>Test Mac</name>
+  </computer>
+</computers>`))
+	})
+
+	ctx := context.Background()
+	computers, _, err := client.Computers.List(ctx)
+	if err != nil {
+		t.Fatalf("Computers.List(): %v", err)
+	}
+
+	want := &ListComputers{
+		Size: ptr(1),
+		Computers: &[]ListComputer{
+			{
+				ID:   ptr(1),
+				Name: ptr("Test Mac"),
+			},
+		},
+	}
+	if !cmp.Equal(computers, want) {
+		t.Errorf("Computers.List() returned %s, want %s", formatWithSpew(computers), formatWithSpew(want))
+	}
+}
+
+func TestComputersService_Match(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computersPath, "match", "C02*"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<computers>
+  <size>1</size>
+  <computer>
+    <id>1</id>
+    <name>Test Mac</name>
+    <serial_number>C02Q7KHTGFWF</serial_number>
+    <username>user</username>
+    <building_name>HQ</building_name>
+  </computer>
+</computers>`))
+	})
+
+	ctx := context.Background()
+	computers, _, err := client.Computers.Match(ctx, "C02*")
+	if err != nil {
+		t.Fatalf("Computers.Match(): %v", err)
+	}
+
+	want := &MatchComputers{
+		Size: ptr(1),
+		Computers: &[]MatchComputer{
+			{
+				ID:           ptr(1),
+				Name:         ptr("Test Mac"),
+				SerialNumber: ptr("C02Q7KHTGFWF"),
+				Username:     ptr("user"),
+				BuildingName: ptr("HQ"),
+			},
+		},
+	}
+	if !cmp.Equal(computers, want) {
+		t.Errorf("Computers.Match() returned %s, want %s", formatWithSpew(computers), formatWithSpew(want))
+	}
+}
+
+func TestComputersService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := 1
+	mux.HandleFunc(buildHandlePath(computersPath, "id", fmt.Sprint(computerID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<computer><location><username>user</username><building>HQ</building></location><extension_attributes><extension_attribute><id>1</id><value>IT</value></extension_attribute></extension_attributes></computer>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Computers.Update(ctx, &Computer{
+		General: &ComputerGeneral{
+			ID:   ptr(computerID),
+			Name: ptr("Test Mac"),
+		},
+		Location: &ComputerLocation{
+			Username: ptr("user"),
+			Building: ptr("HQ"),
+		},
+		ExtensionAttributes: &ComputerExtensionAttributes{
+			ExtensionAttributes: &[]ComputerExtensionAttributeValue{
+				{
+					ID:    ptr(1),
+					Value: ptr("IT"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("Computers.Update(): %v", err)
+	}
+}
@@ -0,0 +1,311 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MacApplicationsService service
+
+type ListMacApplications struct {
+	Size            *int                  `xml:"size,omitempty"`
+	MacApplications *[]ListMacApplication `xml:"mac_application,omitempty"`
+}
+
+type ListMacApplication struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplication struct {
+	General     *MacApplicationGeneral     `xml:"general,omitempty"`
+	Scope       *MacApplicationScope       `xml:"scope,omitempty"`
+	SelfService *MacApplicationSelfService `xml:"self_service,omitempty"`
+	VPP         *MacApplicationVPP         `xml:"vpp,omitempty"`
+}
+
+type MacApplicationGeneral struct {
+	ID             *int                                 `xml:"id,omitempty"`
+	Name           *string                              `xml:"name,omitempty"`
+	Version        *string                              `xml:"version,omitempty"`
+	IsFree         *bool                                `xml:"is_free,omitempty"`
+	BundleID       *string                              `xml:"bundle_id,omitempty"`
+	URL            *string                              `xml:"url,omitempty"`
+	Category       *GeneralCategory                     `xml:"category,omitempty"`
+	Site           *Site                                `xml:"site,omitempty"`
+	DeploymentType *MacApplicationGeneralDeploymentType `xml:"deployment_type,omitempty"`
+}
+
+type MacApplicationGeneralDeploymentType string
+
+const (
+	MacApplicationGeneralDeploymentTypeInstallAutomatically       MacApplicationGeneralDeploymentType = "Install Automatically/Prompt Users to Install"
+	MacApplicationGeneralDeploymentTypeMakeAvailableInSelfService MacApplicationGeneralDeploymentType = "Make Available in Self Service"
+)
+
+type MacApplicationScope struct {
+	AllComputers   *bool                               `xml:"all_computers,omitempty"`
+	AllJSSUsers    *bool                               `xml:"all_jss_users,omitempty"`
+	Computers      *[]MacApplicationScopeComputer      `xml:"computers>computer,omitempty"`
+	ComputerGroups *[]MacApplicationScopeComputerGroup `xml:"computer_groups>computer_group,omitempty"`
+	Buildings      *[]Building                         `xml:"buildings>building,omitempty"`
+	Departments    *[]Department                       `xml:"departments>department,omitempty"`
+	JSSUsers       *[]MacApplicationScopeUser          `xml:"jss_users>user,omitempty"`
+	JSSUserGroups  *[]MacApplicationScopeUserGroup     `xml:"jss_user_groups>user_group,omitempty"`
+	Limitations    *MacApplicationScopeLimitations     `xml:"limitations,omitempty"`
+	Exclusions     *MacApplicationScopeExclusions      `xml:"exclusions,omitempty"`
+}
+
+type MacApplicationScopeComputer struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+	UDID *string `xml:"udid,omitempty"`
+}
+
+type MacApplicationScopeComputerGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationScopeExclusions struct {
+	Computers       *[]MacApplicationScopeComputer             `xml:"computers>computer,omitempty"`
+	ComputerGroups  *[]MacApplicationScopeComputerGroup        `xml:"computer_groups>computer_group,omitempty"`
+	Buildings       *[]Building                                `xml:"buildings>building,omitempty"`
+	Departments     *[]Department                              `xml:"departments>department,omitempty"`
+	Users           *[]MacApplicationScopeLimitationsUser      `xml:"users>user,omitempty"`
+	UserGroups      *[]MacApplicationScopeLimitationsUserGroup `xml:"user_groups>user_group,omitempty"`
+	JSSUsers        *[]MacApplicationScopeUser                 `xml:"jss_users>user,omitempty"`
+	JSSUserGroups   *[]MacApplicationScopeUserGroup            `xml:"jss_user_groups>user_group,omitempty"`
+	NetworkSegments *[]MacApplicationScopeNetworkSegment       `xml:"network_segments>network_segment,omitempty"`
+}
+
+type MacApplicationScopeLimitations struct {
+	Users           *[]MacApplicationScopeLimitationsUser      `xml:"users>user,omitempty"`
+	UserGroups      *[]MacApplicationScopeLimitationsUserGroup `xml:"user_groups>user_group,omitempty"`
+	NetworkSegments *[]MacApplicationScopeNetworkSegment       `xml:"network_segments>network_segment,omitempty"`
+}
+
+type MacApplicationScopeLimitationsUser struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationScopeLimitationsUserGroup struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationScopeNetworkSegment struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationScopeUser struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationScopeUserGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MacApplicationSelfService struct {
+	InstallButtonText           *string                `xml:"install_button_text,omitempty"`
+	SelfServiceDescription      *string                `xml:"self_service_description,omitempty"`
+	ForceUsersToViewDescription *bool                  `xml:"force_users_to_view_description,omitempty"`
+	SelfServiceIcon             *Icon                  `xml:"self_service_icon,omitempty"`
+	FeatureOnMainPage           *bool                  `xml:"feature_on_main_page,omitempty"`
+	SelfServiceCategories       *[]SelfServiceCategory `xml:"self_service_categories>category,omitempty"`
+	NotificationSubject         *string                `xml:"notification_subject,omitempty"`
+	NotificationMessage         *string                `xml:"notification_message,omitempty"`
+}
+
+// MacApplicationVPP is the Volume Purchase Program settings of a Mac App Store app.
+type MacApplicationVPP struct {
+	AssignVPPDeviceBasedLicenses *bool `xml:"assign_vpp_device_based_licenses,omitempty"`
+	VPPAdminAccountID            *int  `xml:"vpp_admin_account_id,omitempty"`
+}
+
+const macApplicationsPath = "/macapplications"
+
+func (s *MacApplicationsService) Create(ctx context.Context, macApplication *MacApplication) (*int, *jamf.Response, error) {
+	if macApplication == nil {
+		return nil, nil, errors.New("MacApplicationsService.Create(): cannot create nil mac application")
+	}
+	if macApplication.General == nil {
+		return nil, nil, errors.New("MacApplicationsService.Create(): cannot create mac application with nil General")
+	}
+	if macApplication.General.Name == nil {
+		return nil, nil, errors.New("MacApplicationsService.Create(): cannot create mac application with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MacApplication
+		XMLName xml.Name `xml:"mac_application"`
+	}{
+		MacApplication: macApplication,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			// When the ID is 0, the ID will be generated automatically at the server side.
+			Entity: path.Join(macApplicationsPath, "id", "0"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"mac_application"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new MacApplication.
+	return data.ID, resp, nil
+}
+
+func (s *MacApplicationsService) Delete(ctx context.Context, macApplicationID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(macApplicationsPath, "id", fmt.Sprint(macApplicationID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *MacApplicationsService) Get(ctx context.Context, macApplicationID int) (*MacApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(macApplicationsPath, "id", fmt.Sprint(macApplicationID)))
+}
+
+func (s *MacApplicationsService) GetByName(ctx context.Context, name string) (*MacApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(macApplicationsPath, "name", name))
+}
+
+func (s *MacApplicationsService) get(ctx context.Context, entity string) (*MacApplication, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var macApplication MacApplication
+	if err := xml.Unmarshal(respBody, &macApplication); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &macApplication, resp, nil
+}
+
+func (s *MacApplicationsService) List(ctx context.Context) (*ListMacApplications, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: macApplicationsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMacApplications ListMacApplications
+	if err := xml.Unmarshal(respBody, &listMacApplications); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMacApplications, resp, nil
+}
+
+func (s *MacApplicationsService) Update(ctx context.Context, macApplication *MacApplication) (*jamf.Response, error) {
+	if macApplication == nil {
+		return nil, errors.New("MacApplicationsService.Update(): cannot update nil mac application")
+	}
+	if macApplication.General == nil {
+		return nil, errors.New("MacApplicationsService.Update(): cannot update mac application with nil General")
+	}
+	if macApplication.General.ID == nil {
+		return nil, errors.New("MacApplicationsService.Update(): cannot update mac application with nil ID of General")
+	}
+	if macApplication.General.Name == nil {
+		return nil, errors.New("MacApplicationsService.Update(): cannot update mac application with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MacApplication
+		XMLName xml.Name `xml:"mac_application"`
+	}{
+		MacApplication: macApplication,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(macApplicationsPath, "id", fmt.Sprint(*macApplication.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
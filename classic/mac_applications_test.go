@@ -0,0 +1,300 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMacApplicationsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(macApplicationsPath, "id", "0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<mac_application><general><name>Xcode</name><bundle_id>com.apple.dt.Xcode</bundle_id><deployment_type>Make Available in Self Service</deployment_type></general><scope><all_computers>true</all_computers></scope><vpp><assign_vpp_device_based_licenses>true</assign_vpp_device_based_licenses><vpp_admin_account_id>1</vpp_admin_account_id></vpp></mac_application>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mac_application>
+  <id>1</id>
+</mac_application>`))
+	})
+
+	ctx := context.Background()
+	macApplicationID, _, err := client.MacApplications.Create(ctx, &MacApplication{
+		General: &MacApplicationGeneral{
+			Name:           ptr("Xcode"),
+			BundleID:       ptr("com.apple.dt.Xcode"),
+			DeploymentType: ptr(MacApplicationGeneralDeploymentTypeMakeAvailableInSelfService),
+		},
+		Scope: &MacApplicationScope{
+			AllComputers: ptr(true),
+		},
+		VPP: &MacApplicationVPP{
+			AssignVPPDeviceBasedLicenses: ptr(true),
+			VPPAdminAccountID:            ptr(1),
+		},
+	})
+	if err != nil {
+		t.Errorf("MacApplications.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(macApplicationID, want) {
+		t.Errorf("MacApplications.Create() returned %s, want %s", formatWithSpew(macApplicationID), formatWithSpew(want))
+	}
+}
+
+func TestMacApplicationsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(macApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mac_application>
+  <id>1</id>
+</mac_application>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.MacApplications.Delete(ctx, 1)
+	if err != nil {
+		t.Errorf("MacApplications.Delete(): %v", err)
+	}
+}
+
+func TestMacApplicationsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(macApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mac_application>
+  <general>
+    <id>1</id>
+    <name>Xcode</name>
+    <version>15.3</version>
+    <is_free>true</is_free>
+    <bundle_id>com.apple.dt.Xcode</bundle_id>
+    <url>https://apps.apple.com/us/app/xcode/id497799835?mt=12</url>
+    <category>
+      <id>1</id>
+      <name>Developer Tools</name>
+    </category>
+    <site>
+      <id>-1</id>
+      <name>None</name>
+    </site>
+    <deployment_type>Install Automatically/Prompt Users to Install</deployment_type>
+  </general>
+  <scope>
+    <all_computers>false</all_computers>
+    <all_jss_users>false</all_jss_users>
+    <computers/>
+    <computer_groups>
+      <computer_group>
+        <id>1</id>
+        <name>Developers</name>
+      </computer_group>
+    </computer_groups>
+    <buildings/>
+    <departments/>
+    <jss_users/>
+    <jss_user_groups/>
+    <limitations>
+      <users/>
+      <user_groups/>
+      <network_segments/>
+    </limitations>
+    <exclusions>
+      <computers>
+        <computer>
+          <id>2</id>
+          <name>Build Machine</name>
+          <udid>55900BDC-347C-58B1-D249-F32244B11D30</udid>
+        </computer>
+      </computers>
+      <computer_groups/>
+      <buildings/>
+      <departments/>
+      <users/>
+      <user_groups/>
+      <network_segments/>
+      <jss_users/>
+      <jss_user_groups/>
+    </exclusions>
+  </scope>
+  <self_service>
+    <install_button_text>Install</install_button_text>
+    <self_service_description>Xcode IDE</self_service_description>
+    <force_users_to_view_description>false</force_users_to_view_description>
+    <self_service_icon>
+      <id>1</id>
+      <uri>https://example.jamfcloud.com/icon?id=1</uri>
+    </self_service_icon>
+    <feature_on_main_page>false</feature_on_main_page>
+    <self_service_categories>
+      <category>
+        <id>1</id>
+        <name>Developer Tools</name>
+        <display_in>true</display_in>
+        <feature_in>false</feature_in>
+      </category>
+    </self_service_categories>
+    <notification>false</notification>
+    <notification_subject>Xcode</notification_subject>
+    <notification_message/>
+  </self_service>
+  <vpp>
+    <assign_vpp_device_based_licenses>true</assign_vpp_device_based_licenses>
+    <vpp_admin_account_id>1</vpp_admin_account_id>
+  </vpp>
+</mac_application>`))
+	})
+
+	ctx := context.Background()
+	macApplication, _, err := client.MacApplications.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("MacApplications.Get(): %v", err)
+	}
+
+	want := &MacApplication{
+		General: &MacApplicationGeneral{
+			ID:       ptr(1),
+			Name:     ptr("Xcode"),
+			Version:  ptr("15.3"),
+			IsFree:   ptr(true),
+			BundleID: ptr("com.apple.dt.Xcode"),
+			URL:      ptr("https://apps.apple.com/us/app/xcode/id497799835?mt=12"),
+			Category: &GeneralCategory{
+				ID:   ptr(1),
+				Name: ptr("Developer Tools"),
+			},
+			Site: &Site{
+				ID:   ptr(-1),
+				Name: ptr("None"),
+			},
+			DeploymentType: ptr(MacApplicationGeneralDeploymentTypeInstallAutomatically),
+		},
+		Scope: &MacApplicationScope{
+			AllComputers: ptr(false),
+			AllJSSUsers:  ptr(false),
+			ComputerGroups: &[]MacApplicationScopeComputerGroup{
+				{
+					ID:   ptr(1),
+					Name: ptr("Developers"),
+				},
+			},
+			Limitations: &MacApplicationScopeLimitations{},
+			Exclusions: &MacApplicationScopeExclusions{
+				Computers: &[]MacApplicationScopeComputer{
+					{
+						ID:   ptr(2),
+						Name: ptr("Build Machine"),
+						UDID: ptr("55900BDC-347C-58B1-D249-F32244B11D30"),
+					},
+				},
+			},
+		},
+		SelfService: &MacApplicationSelfService{
+			InstallButtonText:           ptr("Install"),
+			SelfServiceDescription:      ptr("Xcode IDE"),
+			ForceUsersToViewDescription: ptr(false),
+			SelfServiceIcon: &Icon{
+				ID:  ptr(1),
+				URI: ptr("https://example.jamfcloud.com/icon?id=1"),
+			},
+			FeatureOnMainPage: ptr(false),
+			SelfServiceCategories: &[]SelfServiceCategory{
+				{
+					ID:        ptr(1),
+					Name:      ptr("Developer Tools"),
+					DisplayIn: ptr(true),
+					FeatureIn: ptr(false),
+				},
+			},
+			NotificationSubject: ptr("Xcode"),
+			NotificationMessage: ptr(""),
+		},
+		VPP: &MacApplicationVPP{
+			AssignVPPDeviceBasedLicenses: ptr(true),
+			VPPAdminAccountID:            ptr(1),
+		},
+	}
+	if !cmp.Equal(macApplication, want) {
+		t.Errorf("MacApplications.Get() returned %s, want %s", formatWithSpew(macApplication), formatWithSpew(want))
+	}
+}
+
+func TestMacApplicationsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(macApplicationsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mac_applications>
+  <size>1</size>
+  <mac_application>
+    <id>1</id>
+    <name>Xcode</name>
+  </mac_application>
+</mac_applications>`))
+	})
+
+	ctx := context.Background()
+	macApplications, _, err := client.MacApplications.List(ctx)
+	if err != nil {
+		t.Fatalf("MacApplications.List(): %v", err)
+	}
+
+	want := &ListMacApplications{
+		Size: ptr(1),
+		MacApplications: &[]ListMacApplication{
+			{
+				ID:   ptr(1),
+				Name: ptr("Xcode"),
+			},
+		},
+	}
+	if !cmp.Equal(macApplications, want) {
+		t.Errorf("MacApplications.List() returned %s, want %s", formatWithSpew(macApplications), formatWithSpew(want))
+	}
+}
+
+func TestMacApplicationsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(macApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<mac_application><general><id>1</id><name>Xcode</name></general><self_service><install_button_text>Get</install_button_text></self_service></mac_application>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.MacApplications.Update(ctx, &MacApplication{
+		General: &MacApplicationGeneral{
+			ID:   ptr(1),
+			Name: ptr("Xcode"),
+		},
+		SelfService: &MacApplicationSelfService{
+			InstallButtonText: ptr("Get"),
+		},
+	})
+	if err != nil {
+		t.Errorf("MacApplications.Update(): %v", err)
+	}
+}
@@ -0,0 +1,359 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDeviceApplicationsService service
+
+type ListMobileDeviceApplications struct {
+	Size                     *int                           `xml:"size,omitempty"`
+	MobileDeviceApplications *[]ListMobileDeviceApplication `xml:"mobile_device_application,omitempty"`
+}
+
+type ListMobileDeviceApplication struct {
+	ID          *int    `xml:"id,omitempty"`
+	Name        *string `xml:"name,omitempty"`
+	DisplayName *string `xml:"display_name,omitempty"`
+	BundleID    *string `xml:"bundle_id,omitempty"`
+	Version     *string `xml:"version,omitempty"`
+	InternalApp *bool   `xml:"internal_app,omitempty"`
+}
+
+type MobileDeviceApplication struct {
+	General          *MobileDeviceApplicationGeneral          `xml:"general,omitempty"`
+	Scope            *MobileDeviceApplicationScope            `xml:"scope,omitempty"`
+	SelfService      *MobileDeviceApplicationSelfService      `xml:"self_service,omitempty"`
+	VPP              *MobileDeviceApplicationVPP              `xml:"vpp,omitempty"`
+	AppConfiguration *MobileDeviceApplicationAppConfiguration `xml:"app_configuration,omitempty"`
+}
+
+type MobileDeviceApplicationGeneral struct {
+	ID                               *int                                          `xml:"id,omitempty"`
+	Name                             *string                                       `xml:"name,omitempty"`
+	DisplayName                      *string                                       `xml:"display_name,omitempty"`
+	Description                      *string                                       `xml:"description,omitempty"`
+	BundleID                         *string                                       `xml:"bundle_id,omitempty"`
+	Version                          *string                                       `xml:"version,omitempty"`
+	InternalApp                      *bool                                         `xml:"internal_app,omitempty"`
+	OSType                           *string                                       `xml:"os_type,omitempty"`
+	Category                         *GeneralCategory                              `xml:"category,omitempty"`
+	IPA                              *MobileDeviceApplicationGeneralIPA            `xml:"ipa,omitempty"`
+	Icon                             *Icon                                         `xml:"icon,omitempty"`
+	ProvisioningProfile              *int                                          `xml:"mobile_device_provisioning_profile,omitempty"`
+	ITunesStoreURL                   *string                                       `xml:"itunes_store_url,omitempty"`
+	MakeAvailableAfterInstall        *bool                                         `xml:"make_available_after_install,omitempty"`
+	ITunesCountryRegion              *string                                       `xml:"itunes_country_region,omitempty"`
+	ITunesSyncTime                   *int                                          `xml:"itunes_sync_time,omitempty"`
+	DeploymentType                   *MobileDeviceApplicationGeneralDeploymentType `xml:"deployment_type,omitempty"`
+	DeployAutomatically              *bool                                         `xml:"deploy_automatically,omitempty"`
+	DeployAsManagedApp               *bool                                         `xml:"deploy_as_managed_app,omitempty"`
+	RemoveAppWhenMDMProfileIsRemoved *bool                                         `xml:"remove_app_when_mdm_profile_is_removed,omitempty"`
+	PreventBackupOfAppData           *bool                                         `xml:"prevent_backup_of_app_data,omitempty"`
+	AllowUserToDelete                *bool                                         `xml:"allow_user_to_delete,omitempty"`
+	RequireNetworkTethered           *bool                                         `xml:"require_network_tethered,omitempty"`
+	KeepDescriptionAndIconUpToDate   *bool                                         `xml:"keep_description_and_icon_up_to_date,omitempty"`
+	KeepAppUpdatedOnDevices          *bool                                         `xml:"keep_app_updated_on_devices,omitempty"`
+	Free                             *bool                                         `xml:"free,omitempty"`
+	TakeOverManagement               *bool                                         `xml:"take_over_management,omitempty"`
+	HostExternally                   *bool                                         `xml:"host_externally,omitempty"`
+	ExternalURL                      *string                                       `xml:"external_url,omitempty"`
+	Site                             *Site                                         `xml:"site,omitempty"`
+}
+
+// MobileDeviceApplicationGeneralIPA is the file of an in-house app.
+type MobileDeviceApplicationGeneralIPA struct {
+	Name *string `xml:"name,omitempty"`
+	URI  *string `xml:"uri,omitempty"`
+	Data *string `xml:"data,omitempty"`
+}
+
+type MobileDeviceApplicationGeneralDeploymentType string
+
+const (
+	MobileDeviceApplicationGeneralDeploymentTypeInstallAutomatically       MobileDeviceApplicationGeneralDeploymentType = "Install Automatically/Prompt Users to Install"
+	MobileDeviceApplicationGeneralDeploymentTypeMakeAvailableInSelfService MobileDeviceApplicationGeneralDeploymentType = "Make Available in Self Service"
+)
+
+type MobileDeviceApplicationScope struct {
+	AllMobileDevices   *bool                                            `xml:"all_mobile_devices,omitempty"`
+	AllJSSUsers        *bool                                            `xml:"all_jss_users,omitempty"`
+	MobileDevices      *[]MobileDeviceApplicationScopeMobileDevice      `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups *[]MobileDeviceApplicationScopeMobileDeviceGroup `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          *[]Building                                      `xml:"buildings>building,omitempty"`
+	Departments        *[]Department                                    `xml:"departments>department,omitempty"`
+	JSSUsers           *[]MobileDeviceApplicationScopeUser              `xml:"jss_users>user,omitempty"`
+	JSSUserGroups      *[]MobileDeviceApplicationScopeUserGroup         `xml:"jss_user_groups>user_group,omitempty"`
+	Limitations        *MobileDeviceApplicationScopeLimitations         `xml:"limitations,omitempty"`
+	Exclusions         *MobileDeviceApplicationScopeExclusions          `xml:"exclusions,omitempty"`
+}
+
+type MobileDeviceApplicationScopeMobileDevice struct {
+	ID             *int    `xml:"id,omitempty"`
+	Name           *string `xml:"name,omitempty"`
+	UDID           *string `xml:"udid,omitempty"`
+	WifiMacAddress *string `xml:"wifi_mac_address,omitempty"`
+}
+
+type MobileDeviceApplicationScopeMobileDeviceGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationScopeExclusions struct {
+	MobileDevices      *[]MobileDeviceApplicationScopeMobileDevice         `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups *[]MobileDeviceApplicationScopeMobileDeviceGroup    `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          *[]Building                                         `xml:"buildings>building,omitempty"`
+	Departments        *[]Department                                       `xml:"departments>department,omitempty"`
+	Users              *[]MobileDeviceApplicationScopeLimitationsUser      `xml:"users>user,omitempty"`
+	UserGroups         *[]MobileDeviceApplicationScopeLimitationsUserGroup `xml:"user_groups>user_group,omitempty"`
+	JSSUsers           *[]MobileDeviceApplicationScopeUser                 `xml:"jss_users>user,omitempty"`
+	JSSUserGroups      *[]MobileDeviceApplicationScopeUserGroup            `xml:"jss_user_groups>user_group,omitempty"`
+	NetworkSegments    *[]MobileDeviceApplicationScopeNetworkSegment       `xml:"network_segments>network_segment,omitempty"`
+}
+
+type MobileDeviceApplicationScopeLimitations struct {
+	Users           *[]MobileDeviceApplicationScopeLimitationsUser      `xml:"users>user,omitempty"`
+	UserGroups      *[]MobileDeviceApplicationScopeLimitationsUserGroup `xml:"user_groups>user_group,omitempty"`
+	NetworkSegments *[]MobileDeviceApplicationScopeNetworkSegment       `xml:"network_segments>network_segment,omitempty"`
+}
+
+type MobileDeviceApplicationScopeLimitationsUser struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationScopeLimitationsUserGroup struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationScopeNetworkSegment struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationScopeUser struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationScopeUserGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceApplicationSelfService struct {
+	SelfServiceDescription *string                `xml:"self_service_description,omitempty"`
+	SelfServiceIcon        *Icon                  `xml:"self_service_icon,omitempty"`
+	FeatureOnMainPage      *bool                  `xml:"feature_on_main_page,omitempty"`
+	SelfServiceCategories  *[]SelfServiceCategory `xml:"self_service_categories>category,omitempty"`
+	NotificationSubject    *string                `xml:"notification_subject,omitempty"`
+	NotificationMessage    *string                `xml:"notification_message,omitempty"`
+}
+
+// MobileDeviceApplicationVPP is the Volume Purchase Program settings of an App Store app.
+type MobileDeviceApplicationVPP struct {
+	AssignVPPDeviceBasedLicenses *bool `xml:"assign_vpp_device_based_licenses,omitempty"`
+	VPPAdminAccountID            *int  `xml:"vpp_admin_account_id,omitempty"`
+}
+
+// MobileDeviceApplicationAppConfiguration is the managed app configuration of an app.
+type MobileDeviceApplicationAppConfiguration struct {
+	// Preferences is the plist of the configuration. It is escaped in the XML of the API, and xml.Marshal escapes it as well.
+	Preferences *string `xml:"preferences,omitempty"`
+}
+
+const mobileDeviceApplicationsPath = "/mobiledeviceapplications"
+
+func (s *MobileDeviceApplicationsService) Create(ctx context.Context, mobileDeviceApplication *MobileDeviceApplication) (*int, *jamf.Response, error) {
+	if mobileDeviceApplication == nil {
+		return nil, nil, errors.New("MobileDeviceApplicationsService.Create(): cannot create nil mobile device application")
+	}
+	if mobileDeviceApplication.General == nil {
+		return nil, nil, errors.New("MobileDeviceApplicationsService.Create(): cannot create mobile device application with nil General")
+	}
+	if mobileDeviceApplication.General.Name == nil {
+		return nil, nil, errors.New("MobileDeviceApplicationsService.Create(): cannot create mobile device application with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceApplication
+		XMLName xml.Name `xml:"mobile_device_application"`
+	}{
+		MobileDeviceApplication: mobileDeviceApplication,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			// When the ID is 0, the ID will be generated automatically at the server side.
+			Entity: path.Join(mobileDeviceApplicationsPath, "id", "0"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"mobile_device_application"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new MobileDeviceApplication.
+	return data.ID, resp, nil
+}
+
+func (s *MobileDeviceApplicationsService) Delete(ctx context.Context, mobileDeviceApplicationID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceApplicationsPath, "id", fmt.Sprint(mobileDeviceApplicationID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *MobileDeviceApplicationsService) Get(ctx context.Context, mobileDeviceApplicationID int) (*MobileDeviceApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(mobileDeviceApplicationsPath, "id", fmt.Sprint(mobileDeviceApplicationID)))
+}
+
+func (s *MobileDeviceApplicationsService) GetByName(ctx context.Context, name string) (*MobileDeviceApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(mobileDeviceApplicationsPath, "name", name))
+}
+
+// GetByBundleID returns the app of the bundle ID, e.g. com.apple.Pages.
+func (s *MobileDeviceApplicationsService) GetByBundleID(ctx context.Context, bundleID string) (*MobileDeviceApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(mobileDeviceApplicationsPath, "bundleid", bundleID))
+}
+
+// GetByBundleIDAndVersion returns the app of the bundle ID and the version, e.g. when an in-house app has several versions.
+func (s *MobileDeviceApplicationsService) GetByBundleIDAndVersion(ctx context.Context, bundleID string, version string) (*MobileDeviceApplication, *jamf.Response, error) {
+	return s.get(ctx, path.Join(mobileDeviceApplicationsPath, "bundleid", bundleID, "version", version))
+}
+
+func (s *MobileDeviceApplicationsService) get(ctx context.Context, entity string) (*MobileDeviceApplication, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDeviceApplication MobileDeviceApplication
+	if err := xml.Unmarshal(respBody, &mobileDeviceApplication); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &mobileDeviceApplication, resp, nil
+}
+
+func (s *MobileDeviceApplicationsService) List(ctx context.Context) (*ListMobileDeviceApplications, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDeviceApplicationsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDeviceApplications ListMobileDeviceApplications
+	if err := xml.Unmarshal(respBody, &listMobileDeviceApplications); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDeviceApplications, resp, nil
+}
+
+func (s *MobileDeviceApplicationsService) Update(ctx context.Context, mobileDeviceApplication *MobileDeviceApplication) (*jamf.Response, error) {
+	if mobileDeviceApplication == nil {
+		return nil, errors.New("MobileDeviceApplicationsService.Update(): cannot update nil mobile device application")
+	}
+	if mobileDeviceApplication.General == nil {
+		return nil, errors.New("MobileDeviceApplicationsService.Update(): cannot update mobile device application with nil General")
+	}
+	if mobileDeviceApplication.General.ID == nil {
+		return nil, errors.New("MobileDeviceApplicationsService.Update(): cannot update mobile device application with nil ID of General")
+	}
+	if mobileDeviceApplication.General.Name == nil {
+		return nil, errors.New("MobileDeviceApplicationsService.Update(): cannot update mobile device application with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceApplication
+		XMLName xml.Name `xml:"mobile_device_application"`
+	}{
+		MobileDeviceApplication: mobileDeviceApplication,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceApplicationsPath, "id", fmt.Sprint(*mobileDeviceApplication.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,368 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDeviceApplicationsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath, "id", "0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<mobile_device_application><general><name>Pages</name><bundle_id>com.apple.Pages</bundle_id><version>14.0</version><itunes_store_url>https://apps.apple.com/us/app/pages/id361309726</itunes_store_url><deploy_as_managed_app>true</deploy_as_managed_app></general><scope><all_mobile_devices>true</all_mobile_devices></scope><app_configuration><preferences>&lt;dict&gt;&lt;key&gt;Server&lt;/key&gt;&lt;string&gt;example.com&lt;/string&gt;&lt;/dict&gt;</preferences></app_configuration></mobile_device_application>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_application>
+  <id>1</id>
+</mobile_device_application>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceApplicationID, _, err := client.MobileDeviceApplications.Create(ctx, &MobileDeviceApplication{
+		General: &MobileDeviceApplicationGeneral{
+			Name:               ptr("Pages"),
+			BundleID:           ptr("com.apple.Pages"),
+			Version:            ptr("14.0"),
+			ITunesStoreURL:     ptr("https://apps.apple.com/us/app/pages/id361309726"),
+			DeployAsManagedApp: ptr(true),
+		},
+		Scope: &MobileDeviceApplicationScope{
+			AllMobileDevices: ptr(true),
+		},
+		AppConfiguration: &MobileDeviceApplicationAppConfiguration{
+			Preferences: ptr("<dict><key>Server</key><string>example.com</string></dict>"),
+		},
+	})
+	if err != nil {
+		t.Errorf("MobileDeviceApplications.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(mobileDeviceApplicationID, want) {
+		t.Errorf("MobileDeviceApplications.Create() returned %s, want %s", formatWithSpew(mobileDeviceApplicationID), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceApplicationsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_application>
+  <id>1</id>
+</mobile_device_application>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.MobileDeviceApplications.Delete(ctx, 1)
+	if err != nil {
+		t.Errorf("MobileDeviceApplications.Delete(): %v", err)
+	}
+}
+
+func TestMobileDeviceApplicationsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_application>
+  <general>
+    <id>1</id>
+    <name>Pages</name>
+    <display_name>Pages</display_name>
+    <description>Documents that stand apart.</description>
+    <bundle_id>com.apple.Pages</bundle_id>
+    <version>14.0</version>
+    <internal_app>false</internal_app>
+    <os_type>iOS</os_type>
+    <category>
+      <id>-1</id>
+      <name>No category assigned</name>
+    </category>
+    <ipa>
+      <name/>
+      <uri/>
+      <data/>
+    </ipa>
+    <icon>
+      <id>1</id>
+      <name>Pages.png</name>
+      <uri>https://example.jamfcloud.com/icon?id=1</uri>
+      <data/>
+    </icon>
+    <itunes_store_url>https://apps.apple.com/us/app/pages/id361309726</itunes_store_url>
+    <make_available_after_install>false</make_available_after_install>
+    <itunes_country_region>US</itunes_country_region>
+    <itunes_sync_time>0</itunes_sync_time>
+    <deployment_type>Install Automatically/Prompt Users to Install</deployment_type>
+    <deploy_automatically>true</deploy_automatically>
+    <deploy_as_managed_app>true</deploy_as_managed_app>
+    <remove_app_when_mdm_profile_is_removed>true</remove_app_when_mdm_profile_is_removed>
+    <prevent_backup_of_app_data>false</prevent_backup_of_app_data>
+    <allow_user_to_delete>false</allow_user_to_delete>
+    <require_network_tethered>false</require_network_tethered>
+    <keep_description_and_icon_up_to_date>true</keep_description_and_icon_up_to_date>
+    <keep_app_updated_on_devices>true</keep_app_updated_on_devices>
+    <free>true</free>
+    <take_over_management>false</take_over_management>
+    <host_externally>false</host_externally>
+    <external_url/>
+    <site>
+      <id>-1</id>
+      <name>None</name>
+    </site>
+  </general>
+  <scope>
+    <all_mobile_devices>false</all_mobile_devices>
+    <all_jss_users>false</all_jss_users>
+    <mobile_devices/>
+    <mobile_device_groups>
+      <mobile_device_group>
+        <id>1</id>
+        <name>All Managed iPads</name>
+      </mobile_device_group>
+    </mobile_device_groups>
+    <buildings/>
+    <departments/>
+    <jss_users/>
+    <jss_user_groups/>
+    <limitations>
+      <users/>
+      <user_groups/>
+      <network_segments/>
+    </limitations>
+    <exclusions>
+      <mobile_devices/>
+      <mobile_device_groups/>
+      <buildings/>
+      <departments/>
+      <users/>
+      <user_groups/>
+      <network_segments/>
+      <jss_users/>
+      <jss_user_groups/>
+    </exclusions>
+  </scope>
+  <self_service>
+    <self_service_description/>
+    <self_service_icon/>
+    <feature_on_main_page>false</feature_on_main_page>
+    <self_service_categories/>
+    <notification>false</notification>
+    <notification_subject>Pages</notification_subject>
+    <notification_message/>
+  </self_service>
+  <vpp>
+    <assign_vpp_device_based_licenses>true</assign_vpp_device_based_licenses>
+    <vpp_admin_account_id>1</vpp_admin_account_id>
+  </vpp>
+  <app_configuration>
+    <preferences>&lt;dict&gt;&lt;key&gt;Server&lt;/key&gt;&lt;string&gt;example.com&lt;/string&gt;&lt;/dict&gt;</preferences>
+  </app_configuration>
+</mobile_device_application>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceApplication, _, err := client.MobileDeviceApplications.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("MobileDeviceApplications.Get(): %v", err)
+	}
+
+	want := &MobileDeviceApplication{
+		General: &MobileDeviceApplicationGeneral{
+			ID:          ptr(1),
+			Name:        ptr("Pages"),
+			DisplayName: ptr("Pages"),
+			Description: ptr("Documents that stand apart."),
+			BundleID:    ptr("com.apple.Pages"),
+			Version:     ptr("14.0"),
+			InternalApp: ptr(false),
+			OSType:      ptr("iOS"),
+			Category: &GeneralCategory{
+				ID:   ptr(-1),
+				Name: ptr("No category assigned"),
+			},
+			IPA: &MobileDeviceApplicationGeneralIPA{
+				Name: ptr(""),
+				URI:  ptr(""),
+				Data: ptr(""),
+			},
+			Icon: &Icon{
+				ID:  ptr(1),
+				URI: ptr("https://example.jamfcloud.com/icon?id=1"),
+			},
+			ITunesStoreURL:                   ptr("https://apps.apple.com/us/app/pages/id361309726"),
+			MakeAvailableAfterInstall:        ptr(false),
+			ITunesCountryRegion:              ptr("US"),
+			ITunesSyncTime:                   ptr(0),
+			DeploymentType:                   ptr(MobileDeviceApplicationGeneralDeploymentTypeInstallAutomatically),
+			DeployAutomatically:              ptr(true),
+			DeployAsManagedApp:               ptr(true),
+			RemoveAppWhenMDMProfileIsRemoved: ptr(true),
+			PreventBackupOfAppData:           ptr(false),
+			AllowUserToDelete:                ptr(false),
+			RequireNetworkTethered:           ptr(false),
+			KeepDescriptionAndIconUpToDate:   ptr(true),
+			KeepAppUpdatedOnDevices:          ptr(true),
+			Free:                             ptr(true),
+			TakeOverManagement:               ptr(false),
+			HostExternally:                   ptr(false),
+			ExternalURL:                      ptr(""),
+			Site: &Site{
+				ID:   ptr(-1),
+				Name: ptr("None"),
+			},
+		},
+		Scope: &MobileDeviceApplicationScope{
+			AllMobileDevices: ptr(false),
+			AllJSSUsers:      ptr(false),
+			MobileDeviceGroups: &[]MobileDeviceApplicationScopeMobileDeviceGroup{
+				{
+					ID:   ptr(1),
+					Name: ptr("All Managed iPads"),
+				},
+			},
+			Limitations: &MobileDeviceApplicationScopeLimitations{},
+			Exclusions:  &MobileDeviceApplicationScopeExclusions{},
+		},
+		SelfService: &MobileDeviceApplicationSelfService{
+			SelfServiceDescription: ptr(""),
+			SelfServiceIcon:        &Icon{},
+			FeatureOnMainPage:      ptr(false),
+			NotificationSubject:    ptr("Pages"),
+			NotificationMessage:    ptr(""),
+		},
+		VPP: &MobileDeviceApplicationVPP{
+			AssignVPPDeviceBasedLicenses: ptr(true),
+			VPPAdminAccountID:            ptr(1),
+		},
+		AppConfiguration: &MobileDeviceApplicationAppConfiguration{
+			Preferences: ptr("<dict><key>Server</key><string>example.com</string></dict>"),
+		},
+	}
+	if !cmp.Equal(mobileDeviceApplication, want) {
+		t.Errorf("MobileDeviceApplications.Get() returned %s, want %s", formatWithSpew(mobileDeviceApplication), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceApplicationsService_GetByBundleIDAndVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath, "bundleid", "com.apple.Pages", "version", "14.0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_application>
+  <general>
+    <id>1</id>
+    <name>Pages</name>
+    <bundle_id>com.apple.Pages</bundle_id>
+    <version>14.0</version>
+  </general>
+</mobile_device_application>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceApplication, _, err := client.MobileDeviceApplications.GetByBundleIDAndVersion(ctx, "com.apple.Pages", "14.0")
+	if err != nil {
+		t.Fatalf("MobileDeviceApplications.GetByBundleIDAndVersion(): %v", err)
+	}
+
+	want := &MobileDeviceApplication{
+		General: &MobileDeviceApplicationGeneral{
+			ID:       ptr(1),
+			Name:     ptr("Pages"),
+			BundleID: ptr("com.apple.Pages"),
+			Version:  ptr("14.0"),
+		},
+	}
+	if !cmp.Equal(mobileDeviceApplication, want) {
+		t.Errorf("MobileDeviceApplications.GetByBundleIDAndVersion() returned %s, want %s", formatWithSpew(mobileDeviceApplication), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceApplicationsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_applications>
+  <size>1</size>
+  <mobile_device_application>
+    <id>1</id>
+    <name>Pages</name>
+    <display_name>Pages</display_name>
+    <bundle_id>com.apple.Pages</bundle_id>
+    <version>14.0</version>
+    <internal_app>false</internal_app>
+  </mobile_device_application>
+</mobile_device_applications>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceApplications, _, err := client.MobileDeviceApplications.List(ctx)
+	if err != nil {
+		t.Fatalf("MobileDeviceApplications.List(): %v", err)
+	}
+
+	want := &ListMobileDeviceApplications{
+		Size: ptr(1),
+		MobileDeviceApplications: &[]ListMobileDeviceApplication{
+			{
+				ID:          ptr(1),
+				Name:        ptr("Pages"),
+				DisplayName: ptr("Pages"),
+				BundleID:    ptr("com.apple.Pages"),
+				Version:     ptr("14.0"),
+				InternalApp: ptr(false),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDeviceApplications, want) {
+		t.Errorf("MobileDeviceApplications.List() returned %s, want %s", formatWithSpew(mobileDeviceApplications), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceApplicationsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceApplicationsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<mobile_device_application><general><id>1</id><name>Pages</name><keep_app_updated_on_devices>false</keep_app_updated_on_devices></general></mobile_device_application>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.MobileDeviceApplications.Update(ctx, &MobileDeviceApplication{
+		General: &MobileDeviceApplicationGeneral{
+			ID:                      ptr(1),
+			Name:                    ptr("Pages"),
+			KeepAppUpdatedOnDevices: ptr(false),
+		},
+	})
+	if err != nil {
+		t.Errorf("MobileDeviceApplications.Update(): %v", err)
+	}
+}
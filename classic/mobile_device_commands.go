@@ -0,0 +1,207 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDeviceCommandsService service
+
+type ListMobileDeviceCommands struct {
+	Size                 *int                       `xml:"size,omitempty"`
+	MobileDeviceCommands *[]ListMobileDeviceCommand `xml:"mobile_device_command,omitempty"`
+}
+
+type ListMobileDeviceCommand struct {
+	UUID          *string `xml:"uuid,omitempty"`
+	Command       *string `xml:"command,omitempty"`
+	Username      *string `xml:"username,omitempty"`
+	DateSent      *string `xml:"date_sent,omitempty"`
+	DateSentEpoch *int64  `xml:"date_sent_epoch,omitempty"`
+	DateSentUTC   *string `xml:"date_sent_utc,omitempty"`
+}
+
+// MobileDeviceCommand is used both to send a command and to read it.
+// General is only for sending, and the other fields except MobileDevices are only returned by the API.
+type MobileDeviceCommand struct {
+	General       *MobileDeviceCommandGeneral        `xml:"general,omitempty"`
+	UUID          *string                            `xml:"uuid,omitempty"`
+	Command       *MobileDeviceCommandName           `xml:"command,omitempty"`
+	Username      *string                            `xml:"username,omitempty"`
+	DateSent      *string                            `xml:"date_sent,omitempty"`
+	DateSentEpoch *int64                             `xml:"date_sent_epoch,omitempty"`
+	DateSentUTC   *string                            `xml:"date_sent_utc,omitempty"`
+	MobileDevices *[]MobileDeviceCommandMobileDevice `xml:"mobile_devices>mobile_device,omitempty"`
+}
+
+type MobileDeviceCommandGeneral struct {
+	Command *MobileDeviceCommandName `xml:"command,omitempty"`
+	// DeviceLock
+	LockMessage *string `xml:"lock_message,omitempty"`
+	PhoneNumber *string `xml:"phone_number,omitempty"`
+	// EnableLostMode
+	LostModeMessage       *string `xml:"lost_mode_message,omitempty"`
+	LostModePhone         *string `xml:"lost_mode_phone,omitempty"`
+	LostModeFootnote      *string `xml:"lost_mode_footnote,omitempty"`
+	AlwaysEnforceLostMode *bool   `xml:"always_enforce_lost_mode,omitempty"`
+	LostModeWithSound     *bool   `xml:"lost_mode_with_sound,omitempty"`
+	// EraseDevice
+	PreserveDataPlan       *bool `xml:"preserve_data_plan,omitempty"`
+	DisallowProximitySetup *bool `xml:"disallow_proximity_setup,omitempty"`
+	// DeviceName
+	DeviceName *string `xml:"device_name,omitempty"`
+	// PasscodeLockGracePeriod
+	PasscodeLockGracePeriod *int `xml:"passcode_lock_grace_period,omitempty"`
+	// SetTimeZone
+	TimeZone *string `xml:"time_zone,omitempty"`
+}
+
+type MobileDeviceCommandName string
+
+const (
+	MobileDeviceCommandNameBlankPush                      MobileDeviceCommandName = "BlankPush"
+	MobileDeviceCommandNameClearPasscode                  MobileDeviceCommandName = "ClearPasscode"
+	MobileDeviceCommandNameClearRestrictionsPassword      MobileDeviceCommandName = "ClearRestrictionsPassword"
+	MobileDeviceCommandNameDeviceLock                     MobileDeviceCommandName = "DeviceLock"
+	MobileDeviceCommandNameDeviceName                     MobileDeviceCommandName = "DeviceName"
+	MobileDeviceCommandNameDisableLostMode                MobileDeviceCommandName = "DisableLostMode"
+	MobileDeviceCommandNameEnableLostMode                 MobileDeviceCommandName = "EnableLostMode"
+	MobileDeviceCommandNameEraseDevice                    MobileDeviceCommandName = "EraseDevice"
+	MobileDeviceCommandNamePasscodeLockGracePeriod        MobileDeviceCommandName = "PasscodeLockGracePeriod"
+	MobileDeviceCommandNamePlayLostModeSound              MobileDeviceCommandName = "PlayLostModeSound"
+	MobileDeviceCommandNameRestartDevice                  MobileDeviceCommandName = "RestartDevice"
+	MobileDeviceCommandNameSetTimeZone                    MobileDeviceCommandName = "SetTimeZone"
+	MobileDeviceCommandNameSettingsDisableBluetooth       MobileDeviceCommandName = "SettingsDisableBluetooth"
+	MobileDeviceCommandNameSettingsDisableDataRoaming     MobileDeviceCommandName = "SettingsDisableDataRoaming"
+	MobileDeviceCommandNameSettingsDisablePersonalHotspot MobileDeviceCommandName = "SettingsDisablePersonalHotspot"
+	MobileDeviceCommandNameSettingsDisableVoiceRoaming    MobileDeviceCommandName = "SettingsDisableVoiceRoaming"
+	MobileDeviceCommandNameSettingsEnableBluetooth        MobileDeviceCommandName = "SettingsEnableBluetooth"
+	MobileDeviceCommandNameSettingsEnableDataRoaming      MobileDeviceCommandName = "SettingsEnableDataRoaming"
+	MobileDeviceCommandNameSettingsEnablePersonalHotspot  MobileDeviceCommandName = "SettingsEnablePersonalHotspot"
+	MobileDeviceCommandNameSettingsEnableVoiceRoaming     MobileDeviceCommandName = "SettingsEnableVoiceRoaming"
+	MobileDeviceCommandNameShutDownDevice                 MobileDeviceCommandName = "ShutDownDevice"
+	MobileDeviceCommandNameUnmanageDevice                 MobileDeviceCommandName = "UnmanageDevice"
+	MobileDeviceCommandNameUpdateInventory                MobileDeviceCommandName = "UpdateInventory"
+)
+
+type MobileDeviceCommandMobileDevice struct {
+	ID           *int    `xml:"id,omitempty"`
+	UDID         *string `xml:"udid,omitempty"`
+	ManagementID *string `xml:"management_id,omitempty"`
+	Status       *string `xml:"status,omitempty"`
+}
+
+const mobileDeviceCommandsPath = "/mobiledevicecommands"
+
+// Create sends the command to the mobile devices. The API returns the command with the UUID.
+func (s *MobileDeviceCommandsService) Create(ctx context.Context, mobileDeviceCommand *MobileDeviceCommand) (*MobileDeviceCommand, *jamf.Response, error) {
+	if mobileDeviceCommand == nil {
+		return nil, nil, errors.New("MobileDeviceCommandsService.Create(): cannot create nil mobile device command")
+	}
+	if mobileDeviceCommand.General == nil || mobileDeviceCommand.General.Command == nil {
+		return nil, nil, errors.New("MobileDeviceCommandsService.Create(): cannot create mobile device command with nil Command of General")
+	}
+	if mobileDeviceCommand.MobileDevices == nil || len(*mobileDeviceCommand.MobileDevices) == 0 {
+		return nil, nil, errors.New("MobileDeviceCommandsService.Create(): cannot create mobile device command with empty MobileDevices")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceCommand
+		XMLName xml.Name `xml:"mobile_device_command"`
+	}{
+		MobileDeviceCommand: mobileDeviceCommand,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceCommandsPath, "command"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var createdMobileDeviceCommand MobileDeviceCommand
+	if err := xml.Unmarshal(respBody, &createdMobileDeviceCommand); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &createdMobileDeviceCommand, resp, nil
+}
+
+func (s *MobileDeviceCommandsService) GetByUUID(ctx context.Context, commandUUID string) (*MobileDeviceCommand, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceCommandsPath, "uuid", commandUUID),
+			Route:  path.Join(mobileDeviceCommandsPath, "uuid", "{uuid}"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDeviceCommand MobileDeviceCommand
+	if err := xml.Unmarshal(respBody, &mobileDeviceCommand); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &mobileDeviceCommand, resp, nil
+}
+
+func (s *MobileDeviceCommandsService) List(ctx context.Context) (*ListMobileDeviceCommands, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDeviceCommandsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDeviceCommands ListMobileDeviceCommands
+	if err := xml.Unmarshal(respBody, &listMobileDeviceCommands); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDeviceCommands, resp, nil
+}
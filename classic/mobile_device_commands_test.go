@@ -0,0 +1,153 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDeviceCommandsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceCommandsPath, "command"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<mobile_device_command><general><command>EnableLostMode</command><lost_mode_message>This device is lost.</lost_mode_message><lost_mode_phone>0120-000-000</lost_mode_phone></general><mobile_devices><mobile_device><id>1</id></mobile_device></mobile_devices></mobile_device_command>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_command>
+  <uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</uuid>
+  <command>EnableLostMode</command>
+  <mobile_devices>
+    <mobile_device>
+      <id>1</id>
+      <management_id>bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937</management_id>
+    </mobile_device>
+  </mobile_devices>
+</mobile_device_command>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceCommand, _, err := client.MobileDeviceCommands.Create(ctx, &MobileDeviceCommand{
+		General: &MobileDeviceCommandGeneral{
+			Command:         ptr(MobileDeviceCommandNameEnableLostMode),
+			LostModeMessage: ptr("This device is lost."),
+			LostModePhone:   ptr("0120-000-000"),
+		},
+		MobileDevices: &[]MobileDeviceCommandMobileDevice{
+			{ID: ptr(1)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("MobileDeviceCommands.Create(): %v", err)
+	}
+
+	want := &MobileDeviceCommand{
+		UUID:    ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		Command: ptr(MobileDeviceCommandNameEnableLostMode),
+		MobileDevices: &[]MobileDeviceCommandMobileDevice{
+			{
+				ID:           ptr(1),
+				ManagementID: ptr("bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937"),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDeviceCommand, want) {
+		t.Errorf("MobileDeviceCommands.Create() returned %s, want %s", formatWithSpew(mobileDeviceCommand), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceCommandsService_GetByUUID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceCommandsPath, "uuid", "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_command>
+  <uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</uuid>
+  <command>DeviceLock</command>
+  <username>admin</username>
+  <date_sent>2024-04-01T10:00:00.000+0000</date_sent>
+  <date_sent_epoch>1711965600000</date_sent_epoch>
+  <date_sent_utc>2024-04-01T10:00:00.000+0000</date_sent_utc>
+  <mobile_devices>
+    <mobile_device>
+      <id>1</id>
+      <udid>0000-1111</udid>
+      <status>Acknowledged</status>
+    </mobile_device>
+  </mobile_devices>
+</mobile_device_command>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceCommand, _, err := client.MobileDeviceCommands.GetByUUID(ctx, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")
+	if err != nil {
+		t.Fatalf("MobileDeviceCommands.GetByUUID(): %v", err)
+	}
+
+	want := &MobileDeviceCommand{
+		UUID:          ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		Command:       ptr(MobileDeviceCommandNameDeviceLock),
+		Username:      ptr("admin"),
+		DateSent:      ptr("2024-04-01T10:00:00.000+0000"),
+		DateSentEpoch: ptr(int64(1711965600000)),
+		DateSentUTC:   ptr("2024-04-01T10:00:00.000+0000"),
+		MobileDevices: &[]MobileDeviceCommandMobileDevice{
+			{
+				ID:     ptr(1),
+				UDID:   ptr("0000-1111"),
+				Status: ptr("Acknowledged"),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDeviceCommand, want) {
+		t.Errorf("MobileDeviceCommands.GetByUUID() returned %s, want %s", formatWithSpew(mobileDeviceCommand), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceCommandsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_commands>
+  <size>1</size>
+  <mobile_device_command>
+    <uuid>aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937</uuid>
+    <command>DeviceLock</command>
+    <username>admin</username>
+  </mobile_device_command>
+</mobile_device_commands>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceCommands, _, err := client.MobileDeviceCommands.List(ctx)
+	if err != nil {
+		t.Fatalf("MobileDeviceCommands.List(): %v", err)
+	}
+
+	want := &ListMobileDeviceCommands{
+		Size: ptr(1),
+		MobileDeviceCommands: &[]ListMobileDeviceCommand{
+			{
+				UUID:     ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+				Command:  ptr("DeviceLock"),
+				Username: ptr("admin"),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDeviceCommands, want) {
+		t.Errorf("MobileDeviceCommands.List() returned %s, want %s", formatWithSpew(mobileDeviceCommands), formatWithSpew(want))
+	}
+}
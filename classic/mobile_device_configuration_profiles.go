@@ -0,0 +1,322 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDeviceConfigurationProfilesService service
+
+type ListMobileDeviceConfigurationProfiles struct {
+	Size                              *int                                    `xml:"size,omitempty"`
+	MobileDeviceConfigurationProfiles *[]ListMobileDeviceConfigurationProfile `xml:"configuration_profile,omitempty"`
+}
+
+type ListMobileDeviceConfigurationProfile struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfile struct {
+	General     *MobileDeviceConfigurationProfileGeneral     `xml:"general,omitempty"`
+	Scope       *MobileDeviceConfigurationProfileScope       `xml:"scope,omitempty"`
+	SelfService *MobileDeviceConfigurationProfileSelfService `xml:"self_service,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileGeneral struct {
+	ID               *int                                                     `xml:"id,omitempty"`
+	Name             *string                                                  `xml:"name,omitempty"`
+	Description      *string                                                  `xml:"description,omitempty"`
+	Site             *Site                                                    `xml:"site,omitempty"`
+	Category         *GeneralCategory                                         `xml:"category,omitempty"`
+	UUID             *string                                                  `xml:"uuid,omitempty"`
+	DeploymentMethod *MobileDeviceConfigurationProfileGeneralDeploymentMethod `xml:"deployment_method,omitempty"`
+	RedeployOnUpdate *MobileDeviceConfigurationProfileGeneralRedeployOnUpdate `xml:"redeploy_on_update,omitempty"`
+	// The element name is in mixed case in the API.
+	RedeployDaysBeforeCertificateExpires *int `xml:"redeploy_Days_before_certificate_expires,omitempty"`
+	// Payloads is the plist of the profile. It is escaped in the XML of the API, and xml.Marshal escapes it as well.
+	Payloads *string `xml:"payloads,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileGeneralDeploymentMethod string
+
+const (
+	MobileDeviceConfigurationProfileGeneralDeploymentMethodInstallAutomatically       MobileDeviceConfigurationProfileGeneralDeploymentMethod = "Install Automatically"
+	MobileDeviceConfigurationProfileGeneralDeploymentMethodMakeAvailableInSelfService MobileDeviceConfigurationProfileGeneralDeploymentMethod = "Make Available in Self Service"
+)
+
+type MobileDeviceConfigurationProfileGeneralRedeployOnUpdate string
+
+const (
+	MobileDeviceConfigurationProfileGeneralRedeployOnUpdateNewlyAssigned MobileDeviceConfigurationProfileGeneralRedeployOnUpdate = "Newly Assigned"
+	MobileDeviceConfigurationProfileGeneralRedeployOnUpdateAll           MobileDeviceConfigurationProfileGeneralRedeployOnUpdate = "All"
+)
+
+type MobileDeviceConfigurationProfileScope struct {
+	AllMobileDevices   *bool                                                     `xml:"all_mobile_devices,omitempty"`
+	AllJSSUsers        *bool                                                     `xml:"all_jss_users,omitempty"`
+	MobileDevices      *[]MobileDeviceConfigurationProfileScopeMobileDevice      `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups *[]MobileDeviceConfigurationProfileScopeMobileDeviceGroup `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          *[]Building                                               `xml:"buildings>building,omitempty"`
+	Departments        *[]Department                                             `xml:"departments>department,omitempty"`
+	JSSUsers           *[]MobileDeviceConfigurationProfileScopeUser              `xml:"jss_users>user,omitempty"`
+	JSSUserGroups      *[]MobileDeviceConfigurationProfileScopeUserGroup         `xml:"jss_user_groups>user_group,omitempty"`
+	Limitations        *MobileDeviceConfigurationProfileScopeLimitations         `xml:"limitations,omitempty"`
+	Exclusions         *MobileDeviceConfigurationProfileScopeExclusions          `xml:"exclusions,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeMobileDevice struct {
+	ID             *int    `xml:"id,omitempty"`
+	Name           *string `xml:"name,omitempty"`
+	UDID           *string `xml:"udid,omitempty"`
+	WifiMacAddress *string `xml:"wifi_mac_address,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeMobileDeviceGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeExclusions struct {
+	MobileDevices      *[]MobileDeviceConfigurationProfileScopeMobileDevice      `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups *[]MobileDeviceConfigurationProfileScopeMobileDeviceGroup `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          *[]Building                                               `xml:"buildings>building,omitempty"`
+	Departments        *[]Department                                             `xml:"departments>department,omitempty"`
+	Users              *[]MobileDeviceConfigurationProfileScopeUser              `xml:"jss_users>user,omitempty"`
+	UserGroups         *[]MobileDeviceConfigurationProfileScopeUserGroup         `xml:"jss_user_groups>user_group,omitempty"`
+	NetworkSegments    *[]MobileDeviceConfigurationProfileScopeNetworkSegment    `xml:"network_segments>network_segment,omitempty"`
+	Ibeacons           *[]MobileDeviceConfigurationProfileScopeIbeacon           `xml:"ibeacons>ibeacon,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeLimitations struct {
+	Users           *[]MobileDeviceConfigurationProfileScopeLimitationsUser      `xml:"users>user,omitempty"`
+	UserGroups      *[]MobileDeviceConfigurationProfileScopeLimitationsUserGroup `xml:"user_groups>user_group,omitempty"`
+	NetworkSegments *[]MobileDeviceConfigurationProfileScopeNetworkSegment       `xml:"network_segments>network_segment,omitempty"`
+	Ibeacons        *[]MobileDeviceConfigurationProfileScopeIbeacon              `xml:"ibeacons>ibeacon,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeLimitationsUser struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeLimitationsUserGroup struct {
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeIbeacon struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeNetworkSegment struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeUser struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileScopeUserGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileSelfService struct {
+	SelfServiceDescription *string                                              `xml:"self_service_description,omitempty"`
+	Security               *MobileDeviceConfigurationProfileSelfServiceSecurity `xml:"security,omitempty"`
+	FeatureOnMainPage      *bool                                                `xml:"feature_on_main_page,omitempty"`
+	SelfServiceCategories  *[]SelfServiceCategory                               `xml:"self_service_categories>category,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileSelfServiceSecurity struct {
+	RemovalDisallowed *MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowed `xml:"removal_disallowed,omitempty"`
+	// Password is required to remove the profile when RemovalDisallowed is "With Authorization".
+	Password *string `xml:"password,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowed string
+
+const (
+	MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowedAlways            MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowed = "Always"
+	MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowedNever             MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowed = "Never"
+	MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowedWithAuthorization MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowed = "With Authorization"
+)
+
+const mobileDeviceConfigurationProfilesPath = "/mobiledeviceconfigurationprofiles"
+
+func (s *MobileDeviceConfigurationProfilesService) Create(ctx context.Context, mobileDeviceConfigurationProfile *MobileDeviceConfigurationProfile) (*int, *jamf.Response, error) {
+	if mobileDeviceConfigurationProfile == nil {
+		return nil, nil, errors.New("MobileDeviceConfigurationProfilesService.Create(): cannot create nil mobile device configuration profile")
+	}
+	if mobileDeviceConfigurationProfile.General == nil {
+		return nil, nil, errors.New("MobileDeviceConfigurationProfilesService.Create(): cannot create mobile device configuration profile with nil General")
+	}
+	if mobileDeviceConfigurationProfile.General.Name == nil {
+		return nil, nil, errors.New("MobileDeviceConfigurationProfilesService.Create(): cannot create mobile device configuration profile with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceConfigurationProfile
+		XMLName xml.Name `xml:"configuration_profile"`
+	}{
+		MobileDeviceConfigurationProfile: mobileDeviceConfigurationProfile,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			// When the ID is 0, the ID will be generated automatically at the server side.
+			Entity: path.Join(mobileDeviceConfigurationProfilesPath, "id", "0"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"configuration_profile"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new MobileDeviceConfigurationProfile.
+	return data.ID, resp, nil
+}
+
+func (s *MobileDeviceConfigurationProfilesService) Delete(ctx context.Context, mobileDeviceConfigurationProfileID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceConfigurationProfilesPath, "id", fmt.Sprint(mobileDeviceConfigurationProfileID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *MobileDeviceConfigurationProfilesService) Get(ctx context.Context, mobileDeviceConfigurationProfileID int) (*MobileDeviceConfigurationProfile, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceConfigurationProfilesPath, "id", fmt.Sprint(mobileDeviceConfigurationProfileID)),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDeviceConfigurationProfile MobileDeviceConfigurationProfile
+	if err := xml.Unmarshal(respBody, &mobileDeviceConfigurationProfile); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &mobileDeviceConfigurationProfile, resp, nil
+}
+
+func (s *MobileDeviceConfigurationProfilesService) List(ctx context.Context) (*ListMobileDeviceConfigurationProfiles, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDeviceConfigurationProfilesPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDeviceConfigurationProfiles ListMobileDeviceConfigurationProfiles
+	if err := xml.Unmarshal(respBody, &listMobileDeviceConfigurationProfiles); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDeviceConfigurationProfiles, resp, nil
+}
+
+func (s *MobileDeviceConfigurationProfilesService) Update(ctx context.Context, mobileDeviceConfigurationProfile *MobileDeviceConfigurationProfile) (*jamf.Response, error) {
+	if mobileDeviceConfigurationProfile == nil {
+		return nil, errors.New("MobileDeviceConfigurationProfilesService.Update(): cannot update nil mobile device configuration profile")
+	}
+	if mobileDeviceConfigurationProfile.General == nil {
+		return nil, errors.New("MobileDeviceConfigurationProfilesService.Update(): cannot update mobile device configuration profile with nil General")
+	}
+	if mobileDeviceConfigurationProfile.General.ID == nil {
+		return nil, errors.New("MobileDeviceConfigurationProfilesService.Update(): cannot update mobile device configuration profile with nil ID of General")
+	}
+	if mobileDeviceConfigurationProfile.General.Name == nil {
+		return nil, errors.New("MobileDeviceConfigurationProfilesService.Update(): cannot update mobile device configuration profile with nil Name of General")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceConfigurationProfile
+		XMLName xml.Name `xml:"configuration_profile"`
+	}{
+		MobileDeviceConfigurationProfile: mobileDeviceConfigurationProfile,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceConfigurationProfilesPath, "id", fmt.Sprint(*mobileDeviceConfigurationProfile.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,309 @@
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDeviceConfigurationProfilesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceConfigurationProfilesPath, "id", "0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<configuration_profile><general><name>Test Wi-Fi</name><payloads>&lt;plist version=&#34;1&#34;&gt;&lt;dict/&gt;&lt;/plist&gt;</payloads></general><scope><all_mobile_devices>false</all_mobile_devices><mobile_device_groups><mobile_device_group><id>1</id></mobile_device_group></mobile_device_groups></scope></configuration_profile>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<configuration_profile>
+  <id>1</id>
+</configuration_profile>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceConfigurationProfileID, _, err := client.MobileDeviceConfigurationProfiles.Create(ctx, &MobileDeviceConfigurationProfile{
+		General: &MobileDeviceConfigurationProfileGeneral{
+			Name:     ptr("Test Wi-Fi"),
+			Payloads: ptr(`<plist version="1"><dict/></plist>`),
+		},
+		Scope: &MobileDeviceConfigurationProfileScope{
+			AllMobileDevices: ptr(false),
+			MobileDeviceGroups: &[]MobileDeviceConfigurationProfileScopeMobileDeviceGroup{
+				{ID: ptr(1)},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("MobileDeviceConfigurationProfiles.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(mobileDeviceConfigurationProfileID, want) {
+		t.Errorf("MobileDeviceConfigurationProfiles.Create() returned %s, want %s", formatWithSpew(mobileDeviceConfigurationProfileID), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceConfigurationProfilesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceConfigurationProfilesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<configuration_profile>
+  <id>1</id>
+</configuration_profile>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceConfigurationProfileID := 1
+	_, err := client.MobileDeviceConfigurationProfiles.Delete(ctx, mobileDeviceConfigurationProfileID)
+	if err != nil {
+		t.Errorf("MobileDeviceConfigurationProfiles.Delete(): %v", err)
+	}
+}
+
+func TestMobileDeviceConfigurationProfilesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceConfigurationProfileID := 1
+	mux.HandleFunc(buildHandlePath(mobileDeviceConfigurationProfilesPath, "id", fmt.Sprint(mobileDeviceConfigurationProfileID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<configuration_profile>
+  <general>
+    <id>1</id>
+    <name>Test Restrictions</name>
+    <description>Test Restrictions Description</description>
+    <site>
+      <id>-1</id>
+      <name>None</name>
+    </site>
+    <category>
+      <id>-1</id>
+      <name>No category assigned</name>
+    </category>
+    <uuid>6E6A6A4B-E9F1-4F5E-9C3A-1C1C1B3B1D2E</uuid>
+    <deployment_method>Install Automatically</deployment_method>
+    <redeploy_on_update>Newly Assigned</redeploy_on_update>
+    <redeploy_Days_before_certificate_expires>0</redeploy_Days_before_certificate_expires>
+    <payloads>&lt;?xml version=&quot;1.0&quot; encoding=&quot;UTF-8&quot;?&gt;&lt;plist version=&quot;1&quot;&gt;&lt;dict&gt;&lt;key&gt;PayloadType&lt;/key&gt;&lt;string&gt;Configuration&lt;/string&gt;&lt;/dict&gt;&lt;/plist&gt;</payloads>
+  </general>
+  <scope>
+    <all_mobile_devices>false</all_mobile_devices>
+    <all_jss_users>false</all_jss_users>
+    <mobile_devices>
+      <mobile_device>
+        <id>1</id>
+        <name>Test iPad</name>
+        <udid>a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0</udid>
+        <wifi_mac_address>52:42:00:3D:ED:44</wifi_mac_address>
+      </mobile_device>
+    </mobile_devices>
+    <buildings>
+      <building>
+        <id>1</id>
+        <name>HQ</name>
+      </building>
+    </buildings>
+    <departments/>
+    <mobile_device_groups>
+      <mobile_device_group>
+        <id>1</id>
+        <name>All Managed iPads</name>
+      </mobile_device_group>
+    </mobile_device_groups>
+    <jss_users/>
+    <jss_user_groups/>
+    <limitations>
+      <users/>
+      <user_groups/>
+      <network_segments>
+        <network_segment>
+          <id>1</id>
+          <name>Office</name>
+        </network_segment>
+      </network_segments>
+      <ibeacons/>
+    </limitations>
+    <exclusions>
+      <mobile_devices/>
+      <buildings/>
+      <departments>
+        <department>
+          <id>2</id>
+          <name>Sales</name>
+        </department>
+      </departments>
+      <mobile_device_groups/>
+      <jss_users/>
+      <jss_user_groups/>
+      <network_segments/>
+      <ibeacons/>
+    </exclusions>
+  </scope>
+  <self_service>
+    <self_service_description/>
+    <security>
+      <removal_disallowed>Never</removal_disallowed>
+    </security>
+    <feature_on_main_page>false</feature_on_main_page>
+    <self_service_categories/>
+  </self_service>
+</configuration_profile>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceConfigurationProfile, _, err := client.MobileDeviceConfigurationProfiles.Get(ctx, mobileDeviceConfigurationProfileID)
+	if err != nil {
+		t.Fatalf("MobileDeviceConfigurationProfiles.Get(): %v", err)
+	}
+
+	want := &MobileDeviceConfigurationProfile{
+		General: &MobileDeviceConfigurationProfileGeneral{
+			ID:          ptr(1),
+			Name:        ptr("Test Restrictions"),
+			Description: ptr("Test Restrictions Description"),
+			Site: &Site{
+				ID:   ptr(-1),
+				Name: ptr("None"),
+			},
+			Category: &GeneralCategory{
+				ID:   ptr(-1),
+				Name: ptr("No category assigned"),
+			},
+			UUID:                                 ptr("6E6A6A4B-E9F1-4F5E-9C3A-1C1C1B3B1D2E"),
+			DeploymentMethod:                     ptr(MobileDeviceConfigurationProfileGeneralDeploymentMethodInstallAutomatically),
+			RedeployOnUpdate:                     ptr(MobileDeviceConfigurationProfileGeneralRedeployOnUpdateNewlyAssigned),
+			RedeployDaysBeforeCertificateExpires: ptr(0),
+			Payloads:                             ptr(`<?xml version="1.0" encoding="UTF-8"?><plist version="1"><dict><key>PayloadType</key><string>Configuration</string></dict></plist>`),
+		},
+		Scope: &MobileDeviceConfigurationProfileScope{
+			AllMobileDevices: ptr(false),
+			AllJSSUsers:      ptr(false),
+			MobileDevices: &[]MobileDeviceConfigurationProfileScopeMobileDevice{
+				{
+					ID:             ptr(1),
+					Name:           ptr("Test iPad"),
+					UDID:           ptr("a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0"),
+					WifiMacAddress: ptr("52:42:00:3D:ED:44"),
+				},
+			},
+			MobileDeviceGroups: &[]MobileDeviceConfigurationProfileScopeMobileDeviceGroup{
+				{
+					ID:   ptr(1),
+					Name: ptr("All Managed iPads"),
+				},
+			},
+			Buildings: &[]Building{
+				{
+					ID:   ptr(1),
+					Name: ptr("HQ"),
+				},
+			},
+			Limitations: &MobileDeviceConfigurationProfileScopeLimitations{
+				NetworkSegments: &[]MobileDeviceConfigurationProfileScopeNetworkSegment{
+					{
+						ID:   ptr(1),
+						Name: ptr("Office"),
+					},
+				},
+			},
+			Exclusions: &MobileDeviceConfigurationProfileScopeExclusions{
+				Departments: &[]Department{
+					{
+						ID:   ptr(2),
+						Name: ptr("Sales"),
+					},
+				},
+			},
+		},
+		SelfService: &MobileDeviceConfigurationProfileSelfService{
+			SelfServiceDescription: ptr(""),
+			Security: &MobileDeviceConfigurationProfileSelfServiceSecurity{
+				RemovalDisallowed: ptr(MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowedNever),
+			},
+			FeatureOnMainPage: ptr(false),
+		},
+	}
+	if !cmp.Equal(mobileDeviceConfigurationProfile, want) {
+		t.Errorf("MobileDeviceConfigurationProfiles.Get() returned %s, want %s", formatWithSpew(mobileDeviceConfigurationProfile), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceConfigurationProfilesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceConfigurationProfilesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<configuration_profiles>
+  <size>1</size>
+  <configuration_profile>
+    <id>1</id>
+    <name>Test Restrictions</name>
+  </configuration_profile>
+</configuration_profiles>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceConfigurationProfiles, _, err := client.MobileDeviceConfigurationProfiles.List(ctx)
+	if err != nil {
+		t.Errorf("MobileDeviceConfigurationProfiles.List(): %v", err)
+	}
+
+	want := &ListMobileDeviceConfigurationProfiles{
+		Size: ptr(1),
+		MobileDeviceConfigurationProfiles: &[]ListMobileDeviceConfigurationProfile{{
+			ID:   ptr(1),
+			Name: ptr("Test Restrictions"),
+		}},
+	}
+	if !cmp.Equal(mobileDeviceConfigurationProfiles, want) {
+		t.Errorf("MobileDeviceConfigurationProfiles.List() returned %s, want %s", formatWithSpew(mobileDeviceConfigurationProfiles), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceConfigurationProfilesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceConfigurationProfileID := 1
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceConfigurationProfilesPath, "id", fmt.Sprint(mobileDeviceConfigurationProfileID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<configuration_profile><general><id>1</id><name>Test Restrictions Updated</name></general><self_service><security><removal_disallowed>With Authorization</removal_disallowed><password>secret</password></security></self_service></configuration_profile>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	mobileDeviceConfigurationProfile := &MobileDeviceConfigurationProfile{
+		General: &MobileDeviceConfigurationProfileGeneral{
+			ID:   ptr(mobileDeviceConfigurationProfileID),
+			Name: ptr("Test Restrictions Updated"),
+		},
+		SelfService: &MobileDeviceConfigurationProfileSelfService{
+			Security: &MobileDeviceConfigurationProfileSelfServiceSecurity{
+				RemovalDisallowed: ptr(MobileDeviceConfigurationProfileSelfServiceSecurityRemovalDisallowedWithAuthorization),
+				Password:          ptr("secret"),
+			},
+		},
+	}
+	_, err := client.MobileDeviceConfigurationProfiles.Update(ctx, mobileDeviceConfigurationProfile)
+	if err != nil {
+		t.Errorf("MobileDeviceConfigurationProfiles.Update(): %v", err)
+	}
+}
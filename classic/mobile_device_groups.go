@@ -0,0 +1,259 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDeviceGroupsService service
+
+type MobileDeviceGroup struct {
+	ID            *int                            `xml:"id,omitempty"`
+	Name          *string                         `xml:"name,omitempty"`
+	IsSmart       *bool                           `xml:"is_smart,omitempty"`
+	Site          *Site                           `xml:"site,omitempty"`
+	Criteria      *MobileDeviceGroupCriteria      `xml:"criteria,omitempty"`
+	MobileDevices *MobileDeviceGroupMobileDevices `xml:"mobile_devices,omitempty"`
+}
+
+type MobileDeviceGroupMobileDevice struct {
+	ID             *int    `xml:"id,omitempty"`
+	Name           *string `xml:"name,omitempty"`
+	MacAddress     *string `xml:"mac_address,omitempty"`
+	UDID           *string `xml:"udid,omitempty"`
+	WifiMacAddress *string `xml:"wifi_mac_address,omitempty"`
+	SerialNumber   *string `xml:"serial_number,omitempty"`
+}
+
+type MobileDeviceGroupMobileDevices struct {
+	Size          *int                             `xml:"size,omitempty"`
+	MobileDevices *[]MobileDeviceGroupMobileDevice `xml:"mobile_device,omitempty"`
+}
+
+type MobileDeviceGroupCriteria struct {
+	Size      *int                                  `xml:"size,omitempty"`
+	Criterion *[]MobileDeviceGroupCriteriaCriterion `xml:"criterion,omitempty"`
+}
+
+type MobileDeviceGroupCriteriaCriterion struct {
+	Name         *string                                       `xml:"name,omitempty"`
+	Priority     *int                                          `xml:"priority,omitempty"`
+	AndOr        *MobileDeviceGroupCriteriaCriterionAndOr      `xml:"and_or,omitempty"`
+	SearchType   *MobileDeviceGroupCriteriaCriterionSearchType `xml:"search_type,omitempty"`
+	Value        *string                                       `xml:"value,omitempty"`
+	OpeningParen *bool                                         `xml:"opening_paren,omitempty"`
+	ClosingParen *bool                                         `xml:"closing_paren,omitempty"`
+}
+
+type MobileDeviceGroupCriteriaCriterionAndOr string
+
+const (
+	MobileDeviceGroupCriteriaCriterionAndOrAnd MobileDeviceGroupCriteriaCriterionAndOr = "and"
+	MobileDeviceGroupCriteriaCriterionAndOrOr  MobileDeviceGroupCriteriaCriterionAndOr = "or"
+)
+
+type MobileDeviceGroupCriteriaCriterionSearchType string
+
+const (
+	MobileDeviceGroupCriteriaCriterionSearchTypeIs                 MobileDeviceGroupCriteriaCriterionSearchType = "is"
+	MobileDeviceGroupCriteriaCriterionSearchTypeIsNot              MobileDeviceGroupCriteriaCriterionSearchType = "is not"
+	MobileDeviceGroupCriteriaCriterionSearchTypeHas                MobileDeviceGroupCriteriaCriterionSearchType = "has"
+	MobileDeviceGroupCriteriaCriterionSearchTypeDoesNotHave        MobileDeviceGroupCriteriaCriterionSearchType = "does not have"
+	MobileDeviceGroupCriteriaCriterionSearchTypeBefore             MobileDeviceGroupCriteriaCriterionSearchType = "before (yyyy-mm-dd)"
+	MobileDeviceGroupCriteriaCriterionSearchTypeAfter              MobileDeviceGroupCriteriaCriterionSearchType = "after (yyyy-mm-dd)"
+	MobileDeviceGroupCriteriaCriterionSearchTypeMoreThanXDaysAgo   MobileDeviceGroupCriteriaCriterionSearchType = "more than x days ago"
+	MobileDeviceGroupCriteriaCriterionSearchTypeLessThanXDaysAgo   MobileDeviceGroupCriteriaCriterionSearchType = "less than x days ago"
+	MobileDeviceGroupCriteriaCriterionSearchTypeLike               MobileDeviceGroupCriteriaCriterionSearchType = "like"
+	MobileDeviceGroupCriteriaCriterionSearchTypeNotLike            MobileDeviceGroupCriteriaCriterionSearchType = "not like"
+	MobileDeviceGroupCriteriaCriterionSearchTypeGreaterThan        MobileDeviceGroupCriteriaCriterionSearchType = "greater than"
+	MobileDeviceGroupCriteriaCriterionSearchTypeLessThan           MobileDeviceGroupCriteriaCriterionSearchType = "less than"
+	MobileDeviceGroupCriteriaCriterionSearchTypeGreaterThanOrEqual MobileDeviceGroupCriteriaCriterionSearchType = "greater than or equal"
+	MobileDeviceGroupCriteriaCriterionSearchTypeLessThanOrEqual    MobileDeviceGroupCriteriaCriterionSearchType = "less than or equal"
+	MobileDeviceGroupCriteriaCriterionSearchTypeMatchesRegex       MobileDeviceGroupCriteriaCriterionSearchType = "matches regex"
+	MobileDeviceGroupCriteriaCriterionSearchTypeDoesNotMatchRegex  MobileDeviceGroupCriteriaCriterionSearchType = "does not match regex"
+)
+
+type ListMobileDeviceGroups struct {
+	Size               *int                     `xml:"size,omitempty"`
+	MobileDeviceGroups *[]ListMobileDeviceGroup `xml:"mobile_device_group,omitempty"`
+}
+
+type ListMobileDeviceGroup struct {
+	ID      *int    `xml:"id,omitempty"`
+	Name    *string `xml:"name,omitempty"`
+	IsSmart *bool   `xml:"is_smart,omitempty"`
+}
+
+const mobileDeviceGroupsPath = "/mobiledevicegroups"
+
+func (s *MobileDeviceGroupsService) Create(ctx context.Context, mobileDeviceGroup *MobileDeviceGroup) (*int, *jamf.Response, error) {
+	if mobileDeviceGroup == nil {
+		return nil, nil, errors.New("MobileDeviceGroupsService.Create(): cannot create nil mobile device group")
+	}
+	if mobileDeviceGroup.Name == nil {
+		return nil, nil, errors.New("MobileDeviceGroupsService.Create(): cannot create mobile device group with nil Name")
+	}
+	if mobileDeviceGroup.IsSmart == nil {
+		return nil, nil, errors.New("MobileDeviceGroupsService.Create(): cannot create mobile device group with nil IsSmart")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceGroup
+		XMLName xml.Name `xml:"mobile_device_group"`
+	}{
+		MobileDeviceGroup: mobileDeviceGroup,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			// When the ID is 0, the ID will be generated automatically at the server side.
+			Entity: path.Join(mobileDeviceGroupsPath, "id", "0"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"mobile_device_group"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new MobileDeviceGroup.
+	return data.ID, resp, nil
+}
+
+func (s *MobileDeviceGroupsService) Delete(ctx context.Context, mobileDeviceGroupID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceGroupsPath, "id", fmt.Sprint(mobileDeviceGroupID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *MobileDeviceGroupsService) Get(ctx context.Context, mobileDeviceGroupID int) (*MobileDeviceGroup, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceGroupsPath, "id", fmt.Sprint(mobileDeviceGroupID)),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDeviceGroup MobileDeviceGroup
+	if err := xml.Unmarshal(respBody, &mobileDeviceGroup); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &mobileDeviceGroup, resp, nil
+}
+
+func (s *MobileDeviceGroupsService) List(ctx context.Context) (*ListMobileDeviceGroups, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDeviceGroupsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDeviceGroups ListMobileDeviceGroups
+	if err := xml.Unmarshal(respBody, &listMobileDeviceGroups); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDeviceGroups, resp, nil
+}
+
+func (s *MobileDeviceGroupsService) Update(ctx context.Context, mobileDeviceGroup *MobileDeviceGroup) (*jamf.Response, error) {
+	if mobileDeviceGroup == nil {
+		return nil, errors.New("MobileDeviceGroupsService.Update(): cannot update nil mobile device group")
+	}
+	if mobileDeviceGroup.Name == nil {
+		return nil, errors.New("MobileDeviceGroupsService.Update(): cannot update mobile device group with nil Name")
+	}
+	if mobileDeviceGroup.IsSmart == nil {
+		return nil, errors.New("MobileDeviceGroupsService.Update(): cannot update mobile device group with nil IsSmart")
+	}
+
+	reqBody := &struct {
+		*MobileDeviceGroup
+		XMLName xml.Name `xml:"mobile_device_group"`
+	}{
+		MobileDeviceGroup: mobileDeviceGroup,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDeviceGroupsPath, "id", fmt.Sprint(*mobileDeviceGroup.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,212 @@
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDeviceGroupsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceGroupsPath, "id", "0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<mobile_device_group><name>Test Mobile Device Group</name><is_smart>true</is_smart></mobile_device_group>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_group>
+  <id>1</id>
+</mobile_device_group>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceGroupID, _, err := client.MobileDeviceGroups.Create(ctx, &MobileDeviceGroup{
+		Name:    ptr("Test Mobile Device Group"),
+		IsSmart: ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("MobileDeviceGroups.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(mobileDeviceGroupID, want) {
+		t.Errorf("MobileDeviceGroups.Create() returned %s, want %s", formatWithSpew(mobileDeviceGroupID), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceGroupsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceGroupsPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_group>
+  <id>1</id>
+</mobile_device_group>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceGroupID := 1
+	_, err := client.MobileDeviceGroups.Delete(ctx, mobileDeviceGroupID)
+	if err != nil {
+		t.Errorf("MobileDeviceGroups.Delete(): %v", err)
+	}
+}
+
+func TestMobileDeviceGroupsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceGroupID := 1
+	mux.HandleFunc(buildHandlePath(mobileDeviceGroupsPath, "id", fmt.Sprint(mobileDeviceGroupID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_group>
+  <id>1</id>
+  <name>Test Mobile Device Group</name>
+  <is_smart>true</is_smart>
+  <site>
+    <id>-1</id>
+    <name>None</name>
+  </site>
+  <criteria>
+    <size>1</size>
+    <criterion>
+      <name>Operating System Version</name>
+      <priority>0</priority>
+      <and_or>and</and_or>
+      <search_type>greater than or equal</search_type>
+      <value>12</value>
+      <opening_paren>false</opening_paren>
+      <closing_paren>false</closing_paren>
+    </criterion>
+  </criteria>
+  <mobile_devices>
+    <size>1</size>
+    <mobile_device>
+      <id>1</id>
+      <name>Test iPad</name>
+      <mac_address>52:42:00:3D:ED:44</mac_address>
+      <udid>a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0</udid>
+      <wifi_mac_address>52:42:00:3D:ED:44</wifi_mac_address>
+      <serial_number>DMPXK1A2B3C4</serial_number>
+    </mobile_device>
+  </mobile_devices>
+</mobile_device_group>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceGroup, _, err := client.MobileDeviceGroups.Get(ctx, mobileDeviceGroupID)
+	if err != nil {
+		t.Fatalf("MobileDeviceGroups.Get(): %v", err)
+	}
+
+	want := &MobileDeviceGroup{
+		ID:      ptr(1),
+		Name:    ptr("Test Mobile Device Group"),
+		IsSmart: ptr(true),
+		Site: &Site{
+			ID:   ptr(-1),
+			Name: ptr("None"),
+		},
+		Criteria: &MobileDeviceGroupCriteria{
+			Size: ptr(1),
+			Criterion: &[]MobileDeviceGroupCriteriaCriterion{{
+				Name:         ptr("Operating System Version"),
+				Priority:     ptr(0),
+				AndOr:        ptr(MobileDeviceGroupCriteriaCriterionAndOrAnd),
+				SearchType:   ptr(MobileDeviceGroupCriteriaCriterionSearchTypeGreaterThanOrEqual),
+				Value:        ptr("12"),
+				OpeningParen: ptr(false),
+				ClosingParen: ptr(false),
+			}},
+		},
+		MobileDevices: &MobileDeviceGroupMobileDevices{
+			Size: ptr(1),
+			MobileDevices: &[]MobileDeviceGroupMobileDevice{{
+				ID:             ptr(1),
+				Name:           ptr("Test iPad"),
+				MacAddress:     ptr("52:42:00:3D:ED:44"),
+				UDID:           ptr("a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0"),
+				WifiMacAddress: ptr("52:42:00:3D:ED:44"),
+				SerialNumber:   ptr("DMPXK1A2B3C4"),
+			}},
+		},
+	}
+	if !cmp.Equal(mobileDeviceGroup, want) {
+		t.Errorf("MobileDeviceGroups.Get() returned %s, want %s", formatWithSpew(mobileDeviceGroup), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceGroupsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceGroupsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device_groups>
+  <size>1</size>
+  <mobile_device_group>
+    <id>1</id>
+    <name>Test Mobile Device Group</name>
+    <is_smart>true</is_smart>
+  </mobile_device_group>
+</mobile_device_groups>`))
+	})
+
+	ctx := context.Background()
+	mobileDeviceGroups, _, err := client.MobileDeviceGroups.List(ctx)
+	if err != nil {
+		t.Fatalf("MobileDeviceGroups.List(): %v", err)
+	}
+
+	want := &ListMobileDeviceGroups{
+		Size: ptr(1),
+		MobileDeviceGroups: &[]ListMobileDeviceGroup{{
+			ID:      ptr(1),
+			Name:    ptr("Test Mobile Device Group"),
+			IsSmart: ptr(true),
+		}},
+	}
+	if !cmp.Equal(mobileDeviceGroups, want) {
+		t.Errorf("MobileDeviceGroups.List() returned %s, want %s", formatWithSpew(mobileDeviceGroups), formatWithSpew(want))
+	}
+}
+
+func TestMobileDeviceGroupsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceGroupID := 1
+
+	mux.HandleFunc(buildHandlePath(mobileDeviceGroupsPath, "id", fmt.Sprint(mobileDeviceGroupID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<mobile_device_group><id>1</id><name>Test Mobile Device Group Updated</name><is_smart>true</is_smart></mobile_device_group>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	mobileDeviceGroup := &MobileDeviceGroup{
+		ID:      ptr(mobileDeviceGroupID),
+		Name:    ptr("Test Mobile Device Group Updated"),
+		IsSmart: ptr(true),
+	}
+	_, err := client.MobileDeviceGroups.Update(ctx, mobileDeviceGroup)
+	if err != nil {
+		t.Errorf("MobileDeviceGroups.Update(): %v", err)
+	}
+}
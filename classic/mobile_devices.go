@@ -0,0 +1,503 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDevicesService service
+
+type ListMobileDevices struct {
+	Size          *int                `xml:"size,omitempty"`
+	MobileDevices *[]ListMobileDevice `xml:"mobile_device,omitempty"`
+}
+
+type ListMobileDevice struct {
+	ID              *int    `xml:"id,omitempty"`
+	Name            *string `xml:"name,omitempty"`
+	DeviceName      *string `xml:"device_name,omitempty"`
+	UDID            *string `xml:"udid,omitempty"`
+	SerialNumber    *string `xml:"serial_number,omitempty"`
+	PhoneNumber     *string `xml:"phone_number,omitempty"`
+	WifiMacAddress  *string `xml:"wifi_mac_address,omitempty"`
+	Managed         *bool   `xml:"managed,omitempty"`
+	Supervised      *bool   `xml:"supervised,omitempty"`
+	Model           *string `xml:"model,omitempty"`
+	ModelIdentifier *string `xml:"model_identifier,omitempty"`
+	ModelDisplay    *string `xml:"model_display,omitempty"`
+	Username        *string `xml:"username,omitempty"`
+}
+
+type MatchMobileDevices struct {
+	Size          *int                 `xml:"size,omitempty"`
+	MobileDevices *[]MatchMobileDevice `xml:"mobile_device,omitempty"`
+}
+
+type MatchMobileDevice struct {
+	ID             *int    `xml:"id,omitempty"`
+	Name           *string `xml:"name,omitempty"`
+	UDID           *string `xml:"udid,omitempty"`
+	SerialNumber   *string `xml:"serial_number,omitempty"`
+	MacAddress     *string `xml:"mac_address,omitempty"`
+	WifiMacAddress *string `xml:"wifi_mac_address,omitempty"`
+	Username       *string `xml:"username,omitempty"`
+	Realname       *string `xml:"realname,omitempty"`
+	Email          *string `xml:"email,omitempty"`
+	EmailAddress   *string `xml:"email_address,omitempty"`
+	Room           *string `xml:"room,omitempty"`
+	Position       *string `xml:"position,omitempty"`
+	Building       *string `xml:"building,omitempty"`
+	BuildingName   *string `xml:"building_name,omitempty"`
+	Department     *string `xml:"department,omitempty"`
+	DepartmentName *string `xml:"department_name,omitempty"`
+}
+
+type MobileDevice struct {
+	General               *MobileDeviceGeneral                        `xml:"general,omitempty"`
+	Location              *MobileDeviceLocation                       `xml:"location,omitempty"`
+	Purchasing            *MobileDevicePurchasing                     `xml:"purchasing,omitempty"`
+	Applications          *MobileDeviceInstalledApplications          `xml:"applications,omitempty"`
+	Security              *MobileDeviceSecurity                       `xml:"security,omitempty"`
+	Network               *MobileDeviceNetwork                        `xml:"network,omitempty"`
+	Certificates          *MobileDeviceCertificates                   `xml:"certificates,omitempty"`
+	ConfigurationProfiles *MobileDeviceInstalledConfigurationProfiles `xml:"configuration_profiles,omitempty"`
+	ProvisioningProfiles  *MobileDeviceProvisioningProfiles           `xml:"provisioning_profiles,omitempty"`
+	MobileDeviceGroups    *MobileDeviceMobileDeviceGroups             `xml:"mobile_device_groups,omitempty"`
+	ExtensionAttributes   *MobileDeviceExtensionAttributes            `xml:"extension_attributes,omitempty"`
+}
+
+type MobileDeviceGeneral struct {
+	ID                                 *int    `xml:"id,omitempty"`
+	DisplayName                        *string `xml:"display_name,omitempty"`
+	DeviceName                         *string `xml:"device_name,omitempty"`
+	Name                               *string `xml:"name,omitempty"`
+	AssetTag                           *string `xml:"asset_tag,omitempty"`
+	LastInventoryUpdate                *string `xml:"last_inventory_update,omitempty"`
+	LastInventoryUpdateEpoch           *int64  `xml:"last_inventory_update_epoch,omitempty"`
+	LastInventoryUpdateUTC             *string `xml:"last_inventory_update_utc,omitempty"`
+	Capacity                           *int    `xml:"capacity,omitempty"`
+	CapacityMB                         *int    `xml:"capacity_mb,omitempty"`
+	Available                          *int    `xml:"available,omitempty"`
+	AvailableMB                        *int    `xml:"available_mb,omitempty"`
+	PercentageUsed                     *int    `xml:"percentage_used,omitempty"`
+	OSType                             *string `xml:"os_type,omitempty"`
+	OSVersion                          *string `xml:"os_version,omitempty"`
+	OSBuild                            *string `xml:"os_build,omitempty"`
+	SerialNumber                       *string `xml:"serial_number,omitempty"`
+	UDID                               *string `xml:"udid,omitempty"`
+	InitialEntryDateEpoch              *int64  `xml:"initial_entry_date_epoch,omitempty"`
+	InitialEntryDateUTC                *string `xml:"initial_entry_date_utc,omitempty"`
+	PhoneNumber                        *string `xml:"phone_number,omitempty"`
+	IPAddress                          *string `xml:"ip_address,omitempty"`
+	WifiMacAddress                     *string `xml:"wifi_mac_address,omitempty"`
+	BluetoothMacAddress                *string `xml:"bluetooth_mac_address,omitempty"`
+	ModemFirmware                      *string `xml:"modem_firmware,omitempty"`
+	Model                              *string `xml:"model,omitempty"`
+	ModelIdentifier                    *string `xml:"model_identifier,omitempty"`
+	ModelNumber                        *string `xml:"model_number,omitempty"`
+	ModelDisplay                       *string `xml:"model_display,omitempty"`
+	DeviceOwnershipLevel               *string `xml:"device_ownership_level,omitempty"`
+	EnrollmentMethod                   *string `xml:"enrollment_method,omitempty"`
+	LastEnrollmentEpoch                *int64  `xml:"last_enrollment_epoch,omitempty"`
+	LastEnrollmentUTC                  *string `xml:"last_enrollment_utc,omitempty"`
+	MDMProfileExpirationEpoch          *int64  `xml:"mdm_profile_expiration_epoch,omitempty"`
+	MDMProfileExpirationUTC            *string `xml:"mdm_profile_expiration_utc,omitempty"`
+	Managed                            *bool   `xml:"managed,omitempty"`
+	Supervised                         *bool   `xml:"supervised,omitempty"`
+	ExchangeActiveSyncDeviceIdentifier *string `xml:"exchange_activesync_device_identifier,omitempty"`
+	Shared                             *string `xml:"shared,omitempty"`
+	DiagnosticSubmission               *string `xml:"diagnostic_submission,omitempty"`
+	AppAnalytics                       *string `xml:"app_analytics,omitempty"`
+	Tethered                           *string `xml:"tethered,omitempty"`
+	BatteryLevel                       *int    `xml:"battery_level,omitempty"`
+	BLECapable                         *bool   `xml:"ble_capable,omitempty"`
+	DeviceLocatorServiceEnabled        *bool   `xml:"device_locator_service_enabled,omitempty"`
+	DoNotDisturbEnabled                *bool   `xml:"do_not_disturb_enabled,omitempty"`
+	CloudBackupEnabled                 *bool   `xml:"cloud_backup_enabled,omitempty"`
+	LastCloudBackupDateEpoch           *int64  `xml:"last_cloud_backup_date_epoch,omitempty"`
+	LastCloudBackupDateUTC             *string `xml:"last_cloud_backup_date_utc,omitempty"`
+	LocationServicesEnabled            *bool   `xml:"location_services_enabled,omitempty"`
+	ITunesStoreAccountIsActive         *bool   `xml:"itunes_store_account_is_active,omitempty"`
+	LastBackupTimeEpoch                *int64  `xml:"last_backup_time_epoch,omitempty"`
+	LastBackupTimeUTC                  *string `xml:"last_backup_time_utc,omitempty"`
+	Site                               *Site   `xml:"site,omitempty"`
+}
+
+type MobileDeviceLocation struct {
+	Username     *string `xml:"username,omitempty"`
+	Realname     *string `xml:"realname,omitempty"`
+	RealName     *string `xml:"real_name,omitempty"`
+	EmailAddress *string `xml:"email_address,omitempty"`
+	Position     *string `xml:"position,omitempty"`
+	Phone        *string `xml:"phone,omitempty"`
+	PhoneNumber  *string `xml:"phone_number,omitempty"`
+	Department   *string `xml:"department,omitempty"`
+	Building     *string `xml:"building,omitempty"`
+	Room         *string `xml:"room,omitempty"`
+}
+
+type MobileDevicePurchasing struct {
+	IsPurchased          *bool                               `xml:"is_purchased,omitempty"`
+	IsLeased             *bool                               `xml:"is_leased,omitempty"`
+	PONumber             *string                             `xml:"po_number,omitempty"`
+	Vendor               *string                             `xml:"vendor,omitempty"`
+	AppleCareID          *string                             `xml:"applecare_id,omitempty"`
+	PurchasePrice        *string                             `xml:"purchase_price,omitempty"`
+	PurchasingAccount    *string                             `xml:"purchasing_account,omitempty"`
+	PODate               *string                             `xml:"po_date,omitempty"`
+	PODateEpoch          *int64                              `xml:"po_date_epoch,omitempty"`
+	PODateUTC            *string                             `xml:"po_date_utc,omitempty"`
+	WarrantyExpires      *string                             `xml:"warranty_expires,omitempty"`
+	WarrantyExpiresEpoch *int64                              `xml:"warranty_expires_epoch,omitempty"`
+	WarrantyExpiresUTC   *string                             `xml:"warranty_expires_utc,omitempty"`
+	LeaseExpires         *string                             `xml:"lease_expires,omitempty"`
+	LeaseExpiresEpoch    *int64                              `xml:"lease_expires_epoch,omitempty"`
+	LeaseExpiresUTC      *string                             `xml:"lease_expires_utc,omitempty"`
+	LifeExpectancy       *int                                `xml:"life_expectancy,omitempty"`
+	PurchasingContact    *string                             `xml:"purchasing_contact,omitempty"`
+	Attachments          *[]MobileDevicePurchasingAttachment `xml:"attachments>attachment,omitempty"`
+}
+
+type MobileDevicePurchasingAttachment struct {
+	ID       *int    `xml:"id,omitempty"`
+	Filename *string `xml:"filename,omitempty"`
+	URI      *string `xml:"uri,omitempty"`
+}
+
+type MobileDeviceInstalledApplications struct {
+	Size         *int                                `xml:"size,omitempty"`
+	Applications *[]MobileDeviceInstalledApplication `xml:"application,omitempty"`
+}
+
+type MobileDeviceInstalledApplication struct {
+	ApplicationName         *string `xml:"application_name,omitempty"`
+	ApplicationVersion      *string `xml:"application_version,omitempty"`
+	ApplicationShortVersion *string `xml:"application_short_version,omitempty"`
+	Identifier              *string `xml:"identifier,omitempty"`
+}
+
+type MobileDeviceSecurity struct {
+	DataProtection                  *bool    `xml:"data_protection,omitempty"`
+	BlockLevelEncryptionCapable     *bool    `xml:"block_level_encryption_capable,omitempty"`
+	FileLevelEncryptionCapable      *bool    `xml:"file_level_encryption_capable,omitempty"`
+	PasscodePresent                 *bool    `xml:"passcode_present,omitempty"`
+	PasscodeCompliant               *bool    `xml:"passcode_compliant,omitempty"`
+	PasscodeCompliantWithProfile    *bool    `xml:"passcode_compliant_with_profile,omitempty"`
+	PasscodeLockGracePeriodEnforced *string  `xml:"passcode_lock_grace_period_enforced,omitempty"`
+	HardwareEncryption              *int     `xml:"hardware_encryption,omitempty"`
+	ActivationLockEnabled           *bool    `xml:"activation_lock_enabled,omitempty"`
+	JailbreakDetected               *string  `xml:"jailbreak_detected,omitempty"`
+	LostModeEnabled                 *string  `xml:"lost_mode_enabled,omitempty"`
+	LostModeEnforced                *bool    `xml:"lost_mode_enforced,omitempty"`
+	LostModeEnableIssuedEpoch       *int64   `xml:"lost_mode_enable_issued_epoch,omitempty"`
+	LostModeEnableIssuedUTC         *string  `xml:"lost_mode_enable_issued_utc,omitempty"`
+	LostModeMessage                 *string  `xml:"lost_mode_message,omitempty"`
+	LostModePhone                   *string  `xml:"lost_mode_phone,omitempty"`
+	LostModeFootnote                *string  `xml:"lost_mode_footnote,omitempty"`
+	LostLocationEpoch               *int64   `xml:"lost_location_epoch,omitempty"`
+	LostLocationUTC                 *string  `xml:"lost_location_utc,omitempty"`
+	LostLocationLatitude            *float64 `xml:"lost_location_latitude,omitempty"`
+	LostLocationLongitude           *float64 `xml:"lost_location_longitude,omitempty"`
+	LostLocationAltitude            *float64 `xml:"lost_location_altitude,omitempty"`
+	LostLocationSpeed               *float64 `xml:"lost_location_speed,omitempty"`
+	LostLocationCourse              *float64 `xml:"lost_location_course,omitempty"`
+	LostLocationHorizontalAccuracy  *float64 `xml:"lost_location_horizontal_accuracy,omitempty"`
+	LostLocationVerticalAccuracy    *float64 `xml:"lost_location_vertical_accuracy,omitempty"`
+}
+
+type MobileDeviceNetwork struct {
+	HomeCarrierNetwork       *string `xml:"home_carrier_network,omitempty"`
+	HomeMobileCountryCode    *string `xml:"home_mobile_country_code,omitempty"`
+	HomeMobileNetworkCode    *string `xml:"home_mobile_network_code,omitempty"`
+	CurrentCarrierNetwork    *string `xml:"current_carrier_network,omitempty"`
+	CurrentMobileCountryCode *string `xml:"current_mobile_country_code,omitempty"`
+	CurrentMobileNetworkCode *string `xml:"current_mobile_network_code,omitempty"`
+	CarrierSettingsVersion   *string `xml:"carrier_settings_version,omitempty"`
+	CellularTechnology       *string `xml:"cellular_technology,omitempty"`
+	IMEI                     *string `xml:"imei,omitempty"`
+	ICCID                    *string `xml:"iccid,omitempty"`
+	MEID                     *string `xml:"meid,omitempty"`
+	VoiceRoamingEnabled      *string `xml:"voice_roaming_enabled,omitempty"`
+	DataRoamingEnabled       *bool   `xml:"data_roaming_enabled,omitempty"`
+	Roaming                  *bool   `xml:"roaming,omitempty"`
+	PhoneNumber              *string `xml:"phone_number,omitempty"`
+}
+
+type MobileDeviceCertificates struct {
+	Size         *int                       `xml:"size,omitempty"`
+	Certificates *[]MobileDeviceCertificate `xml:"certificate,omitempty"`
+}
+
+type MobileDeviceCertificate struct {
+	CommonName *string `xml:"common_name,omitempty"`
+	Identity   *bool   `xml:"identity,omitempty"`
+}
+
+type MobileDeviceInstalledConfigurationProfiles struct {
+	Size                  *int                                         `xml:"size,omitempty"`
+	ConfigurationProfiles *[]MobileDeviceInstalledConfigurationProfile `xml:"configuration_profile,omitempty"`
+}
+
+type MobileDeviceInstalledConfigurationProfile struct {
+	DisplayName *string `xml:"display_name,omitempty"`
+	Version     *string `xml:"version,omitempty"`
+	Identifier  *string `xml:"identifier,omitempty"`
+	UUID        *string `xml:"uuid,omitempty"`
+}
+
+type MobileDeviceProvisioningProfiles struct {
+	Size                 *int                               `xml:"size,omitempty"`
+	ProvisioningProfiles *[]MobileDeviceProvisioningProfile `xml:"mobile_device_provisioning_profile,omitempty"`
+}
+
+type MobileDeviceProvisioningProfile struct {
+	DisplayName         *string `xml:"display_name,omitempty"`
+	ExpirationDate      *string `xml:"expiration_date,omitempty"`
+	ExpirationDateEpoch *int64  `xml:"expiration_date_epoch,omitempty"`
+	ExpirationDateUTC   *string `xml:"expiration_date_utc,omitempty"`
+	UUID                *string `xml:"uuid,omitempty"`
+}
+
+type MobileDeviceMobileDeviceGroups struct {
+	Size               *int                             `xml:"size,omitempty"`
+	MobileDeviceGroups *[]MobileDeviceMobileDeviceGroup `xml:"mobile_device_group,omitempty"`
+}
+
+type MobileDeviceMobileDeviceGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type MobileDeviceExtensionAttributes struct {
+	ExtensionAttributes *[]MobileDeviceExtensionAttributeValue `xml:"extension_attribute,omitempty"`
+}
+
+// MobileDeviceExtensionAttributeValue is the value of an extension attribute of a mobile device.
+// Only ID and Value are required to update it.
+type MobileDeviceExtensionAttributeValue struct {
+	ID         *int    `xml:"id,omitempty"`
+	Name       *string `xml:"name,omitempty"`
+	Type       *string `xml:"type,omitempty"`
+	MultiValue *bool   `xml:"multi_value,omitempty"`
+	Value      *string `xml:"value,omitempty"`
+}
+
+// MobileDeviceKey is the key to identify a mobile device in the path of the API.
+type MobileDeviceKey string
+
+const (
+	MobileDeviceKeyID           MobileDeviceKey = "id"
+	MobileDeviceKeyName         MobileDeviceKey = "name"
+	MobileDeviceKeyUDID         MobileDeviceKey = "udid"
+	MobileDeviceKeySerialNumber MobileDeviceKey = "serialnumber"
+	MobileDeviceKeyMacAddress   MobileDeviceKey = "macaddress"
+)
+
+// MobileDeviceSubset is a section of the inventory of a mobile device, which can be retrieved with GetSubset.
+type MobileDeviceSubset string
+
+const (
+	MobileDeviceSubsetGeneral               MobileDeviceSubset = "General"
+	MobileDeviceSubsetLocation              MobileDeviceSubset = "Location"
+	MobileDeviceSubsetPurchasing            MobileDeviceSubset = "Purchasing"
+	MobileDeviceSubsetApplications          MobileDeviceSubset = "Applications"
+	MobileDeviceSubsetSecurity              MobileDeviceSubset = "Security"
+	MobileDeviceSubsetNetwork               MobileDeviceSubset = "Network"
+	MobileDeviceSubsetCertificates          MobileDeviceSubset = "Certificates"
+	MobileDeviceSubsetConfigurationProfiles MobileDeviceSubset = "ConfigurationProfiles"
+	MobileDeviceSubsetProvisioningProfiles  MobileDeviceSubset = "ProvisioningProfiles"
+	MobileDeviceSubsetMobileDeviceGroups    MobileDeviceSubset = "MobileDeviceGroups"
+	MobileDeviceSubsetExtensionAttributes   MobileDeviceSubset = "ExtensionAttributes"
+)
+
+const mobileDevicesPath = "/mobiledevices"
+
+func (s *MobileDevicesService) Delete(ctx context.Context, mobileDeviceID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, string(MobileDeviceKeyID), fmt.Sprint(mobileDeviceID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *MobileDevicesService) Get(ctx context.Context, mobileDeviceID int) (*MobileDevice, *jamf.Response, error) {
+	return s.GetBy(ctx, MobileDeviceKeyID, fmt.Sprint(mobileDeviceID))
+}
+
+func (s *MobileDevicesService) GetByName(ctx context.Context, name string) (*MobileDevice, *jamf.Response, error) {
+	return s.GetBy(ctx, MobileDeviceKeyName, name)
+}
+
+func (s *MobileDevicesService) GetByUDID(ctx context.Context, udid string) (*MobileDevice, *jamf.Response, error) {
+	return s.GetBy(ctx, MobileDeviceKeyUDID, udid)
+}
+
+func (s *MobileDevicesService) GetBySerialNumber(ctx context.Context, serialNumber string) (*MobileDevice, *jamf.Response, error) {
+	return s.GetBy(ctx, MobileDeviceKeySerialNumber, serialNumber)
+}
+
+func (s *MobileDevicesService) GetByMacAddress(ctx context.Context, macAddress string) (*MobileDevice, *jamf.Response, error) {
+	return s.GetBy(ctx, MobileDeviceKeyMacAddress, macAddress)
+}
+
+// GetBy returns the full inventory of a mobile device identified by the key.
+func (s *MobileDevicesService) GetBy(ctx context.Context, key MobileDeviceKey, value string) (*MobileDevice, *jamf.Response, error) {
+	return s.get(ctx, path.Join(mobileDevicesPath, string(key), value))
+}
+
+// GetSubset returns only the sections of the inventory of a mobile device, which is much faster than the full inventory.
+func (s *MobileDevicesService) GetSubset(ctx context.Context, key MobileDeviceKey, value string, subsets ...MobileDeviceSubset) (*MobileDevice, *jamf.Response, error) {
+	if len(subsets) == 0 {
+		return nil, nil, errors.New("MobileDevicesService.GetSubset(): cannot get mobile device with no subsets")
+	}
+
+	names := make([]string, len(subsets))
+	for i, subset := range subsets {
+		names[i] = string(subset)
+	}
+
+	return s.get(ctx, path.Join(mobileDevicesPath, string(key), value, "subset", strings.Join(names, "&")))
+}
+
+func (s *MobileDevicesService) get(ctx context.Context, entity string) (*MobileDevice, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDevice MobileDevice
+	if err := xml.Unmarshal(respBody, &mobileDevice); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &mobileDevice, resp, nil
+}
+
+func (s *MobileDevicesService) List(ctx context.Context) (*ListMobileDevices, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDevicesPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDevices ListMobileDevices
+	if err := xml.Unmarshal(respBody, &listMobileDevices); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDevices, resp, nil
+}
+
+// Match searches mobile devices for the term, which matches e.g. the name, the serial number and the username.
+// The term can contain '*' as a wildcard.
+func (s *MobileDevicesService) Match(ctx context.Context, term string) (*MatchMobileDevices, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, "match", term),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var matchMobileDevices MatchMobileDevices
+	if err := xml.Unmarshal(respBody, &matchMobileDevices); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &matchMobileDevices, resp, nil
+}
+
+// Update updates the location, the purchasing and the extension attribute values of a mobile device identified by ID of General.
+// The other sections are collected from the mobile device by inventory, and are not sent.
+func (s *MobileDevicesService) Update(ctx context.Context, mobileDevice *MobileDevice) (*jamf.Response, error) {
+	if mobileDevice == nil {
+		return nil, errors.New("MobileDevicesService.Update(): cannot update nil mobile device")
+	}
+	if mobileDevice.General == nil || mobileDevice.General.ID == nil {
+		return nil, errors.New("MobileDevicesService.Update(): cannot update mobile device with nil ID of General")
+	}
+
+	reqBody := &struct {
+		XMLName             xml.Name                         `xml:"mobile_device"`
+		Location            *MobileDeviceLocation            `xml:"location,omitempty"`
+		Purchasing          *MobileDevicePurchasing          `xml:"purchasing,omitempty"`
+		ExtensionAttributes *MobileDeviceExtensionAttributes `xml:"extension_attributes,omitempty"`
+	}{
+		Location:            mobileDevice.Location,
+		Purchasing:          mobileDevice.Purchasing,
+		ExtensionAttributes: mobileDevice.ExtensionAttributes,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, string(MobileDeviceKeyID), fmt.Sprint(*mobileDevice.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
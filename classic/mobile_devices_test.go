@@ -0,0 +1,334 @@
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDevicesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := 1
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, "id", fmt.Sprint(mobileDeviceID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device>
+  <id>1</id>
+</mobile_device>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.MobileDevices.Delete(ctx, mobileDeviceID)
+	if err != nil {
+		t.Errorf("MobileDevices.Delete(): %v", err)
+	}
+}
+
+func TestMobileDevicesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := 1
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, "id", fmt.Sprint(mobileDeviceID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device>
+  <general>
+    <id>1</id>
+    <display_name>Test iPad</display_name>
+    <device_name>Test iPad</device_name>
+    <name>Test iPad</name>
+    <os_type>iPadOS</os_type>
+    <os_version>17.4</os_version>
+    <serial_number>DMPXK1A2B3C4</serial_number>
+    <udid>a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0</udid>
+    <wifi_mac_address>52:42:00:3D:ED:44</wifi_mac_address>
+    <managed>true</managed>
+    <supervised>true</supervised>
+    <battery_level>87</battery_level>
+    <site>
+      <id>-1</id>
+      <name>None</name>
+    </site>
+  </general>
+  <location>
+    <username>user</username>
+    <building>HQ</building>
+  </location>
+  <security>
+    <data_protection>true</data_protection>
+    <passcode_present>true</passcode_present>
+    <lost_mode_enabled>false</lost_mode_enabled>
+  </security>
+  <applications>
+    <size>1</size>
+    <application>
+      <application_name>Self Service</application_name>
+      <application_version>10.10.2</application_version>
+      <application_short_version>10.10.2</application_short_version>
+      <identifier>com.jamfsoftware.selfservice</identifier>
+    </application>
+  </applications>
+  <mobile_device_groups>
+    <size>1</size>
+    <mobile_device_group>
+      <id>1</id>
+      <name>All Managed iPads</name>
+    </mobile_device_group>
+  </mobile_device_groups>
+  <extension_attributes>
+    <extension_attribute>
+      <id>1</id>
+      <name>Department</name>
+      <type>String</type>
+      <multi_value>false</multi_value>
+      <value>IT</value>
+    </extension_attribute>
+  </extension_attributes>
+</mobile_device>`))
+	})
+
+	ctx := context.Background()
+	mobileDevice, _, err := client.MobileDevices.Get(ctx, mobileDeviceID)
+	if err != nil {
+		t.Fatalf("MobileDevices.Get(): %v", err)
+	}
+
+	want := &MobileDevice{
+		General: &MobileDeviceGeneral{
+			ID:             ptr(1),
+			DisplayName:    ptr("Test iPad"),
+			DeviceName:     ptr("Test iPad"),
+			Name:           ptr("Test iPad"),
+			OSType:         ptr("iPadOS"),
+			OSVersion:      ptr("17.4"),
+			SerialNumber:   ptr("DMPXK1A2B3C4"),
+			UDID:           ptr("a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0"),
+			WifiMacAddress: ptr("52:42:00:3D:ED:44"),
+			Managed:        ptr(true),
+			Supervised:     ptr(true),
+			BatteryLevel:   ptr(87),
+			Site: &Site{
+				ID:   ptr(-1),
+				Name: ptr("None"),
+			},
+		},
+		Location: &MobileDeviceLocation{
+			Username: ptr("user"),
+			Building: ptr("HQ"),
+		},
+		Security: &MobileDeviceSecurity{
+			DataProtection:  ptr(true),
+			PasscodePresent: ptr(true),
+			LostModeEnabled: ptr("false"),
+		},
+		Applications: &MobileDeviceInstalledApplications{
+			Size: ptr(1),
+			Applications: &[]MobileDeviceInstalledApplication{
+				{
+					ApplicationName:         ptr("Self Service"),
+					ApplicationVersion:      ptr("10.10.2"),
+					ApplicationShortVersion: ptr("10.10.2"),
+					Identifier:              ptr("com.jamfsoftware.selfservice"),
+				},
+			},
+		},
+		MobileDeviceGroups: &MobileDeviceMobileDeviceGroups{
+			Size: ptr(1),
+			MobileDeviceGroups: &[]MobileDeviceMobileDeviceGroup{
+				{
+					ID:   ptr(1),
+					Name: ptr("All Managed iPads"),
+				},
+			},
+		},
+		ExtensionAttributes: &MobileDeviceExtensionAttributes{
+			ExtensionAttributes: &[]MobileDeviceExtensionAttributeValue{
+				{
+					ID:         ptr(1),
+					Name:       ptr("Department"),
+					Type:       ptr("String"),
+					MultiValue: ptr(false),
+					Value:      ptr("IT"),
+				},
+			},
+		},
+	}
+	if !cmp.Equal(mobileDevice, want) {
+		t.Errorf("MobileDevices.Get() returned %s, want %s", formatWithSpew(mobileDevice), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_GetSubset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, "serialnumber", "DMPXK1A2B3C4", "subset", "General&Network"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_device>
+  <general>
+    <id>1</id>
+    <name>Test iPad</name>
+  </general>
+  <network>
+    <current_carrier_network>Carrier</current_carrier_network>
+    <imei>35 000000 000000 0</imei>
+    <data_roaming_enabled>false</data_roaming_enabled>
+  </network>
+</mobile_device>`))
+	})
+
+	ctx := context.Background()
+	mobileDevice, _, err := client.MobileDevices.GetSubset(ctx, MobileDeviceKeySerialNumber, "DMPXK1A2B3C4", MobileDeviceSubsetGeneral, MobileDeviceSubsetNetwork)
+	if err != nil {
+		t.Fatalf("MobileDevices.GetSubset(): %v", err)
+	}
+
+	want := &MobileDevice{
+		General: &MobileDeviceGeneral{
+			ID:   ptr(1),
+			Name: ptr("Test iPad"),
+		},
+		Network: &MobileDeviceNetwork{
+			CurrentCarrierNetwork: ptr("Carrier"),
+			IMEI:                  ptr("35 000000 000000 0"),
+			DataRoamingEnabled:    ptr(false),
+		},
+	}
+	if !cmp.Equal(mobileDevice, want) {
+		t.Errorf("MobileDevices.GetSubset() returned %s, want %s", formatWithSpew(mobileDevice), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_devices>
+  <size>1</size>
+  <mobile_device>
+    <id>1</id>
+    <name>Test iPad</name>
+    <device_name>Test iPad</device_name>
+    <serial_number>DMPXK1A2B3C4</serial_number>
+    <managed>true</managed>
+    <supervised>true</supervised>
+    <model_identifier>iPad13,18</model_identifier>
+  </mobile_device>
+</mobile_devices>`))
+	})
+
+	ctx := context.Background()
+	mobileDevices, _, err := client.MobileDevices.List(ctx)
+	if err != nil {
+		t.Fatalf("MobileDevices.List(): %v", err)
+	}
+
+	want := &ListMobileDevices{
+		Size: ptr(1),
+		MobileDevices: &[]ListMobileDevice{
+			{
+				ID:              ptr(1),
+				Name:            ptr("Test iPad"),
+				DeviceName:      ptr("Test iPad"),
+				SerialNumber:    ptr("DMPXK1A2B3C4"),
+				Managed:         ptr(true),
+				Supervised:      ptr(true),
+				ModelIdentifier: ptr("iPad13,18"),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDevices, want) {
+		t.Errorf("MobileDevices.List() returned %s, want %s", formatWithSpew(mobileDevices), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_Match(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, "match", "DMP*"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<mobile_devices>
+  <size>1</size>
+  <mobile_device>
+    <id>1</id>
+    <name>Test iPad</name>
+    <serial_number>DMPXK1A2B3C4</serial_number>
+    <username>user</username>
+  </mobile_device>
+</mobile_devices>`))
+	})
+
+	ctx := context.Background()
+	mobileDevices, _, err := client.MobileDevices.Match(ctx, "DMP*")
+	if err != nil {
+		t.Fatalf("MobileDevices.Match(): %v", err)
+	}
+
+	want := &MatchMobileDevices{
+		Size: ptr(1),
+		MobileDevices: &[]MatchMobileDevice{
+			{
+				ID:           ptr(1),
+				Name:         ptr("Test iPad"),
+				SerialNumber: ptr("DMPXK1A2B3C4"),
+				Username:     ptr("user"),
+			},
+		},
+	}
+	if !cmp.Equal(mobileDevices, want) {
+		t.Errorf("MobileDevices.Match() returned %s, want %s", formatWithSpew(mobileDevices), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := 1
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, "id", fmt.Sprint(mobileDeviceID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<mobile_device><location><username>user</username><room>101</room></location><purchasing><po_number>PO-1</po_number></purchasing></mobile_device>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.MobileDevices.Update(ctx, &MobileDevice{
+		General: &MobileDeviceGeneral{
+			ID:   ptr(mobileDeviceID),
+			Name: ptr("Test iPad"),
+		},
+		Location: &MobileDeviceLocation{
+			Username: ptr("user"),
+			Room:     ptr("101"),
+		},
+		Purchasing: &MobileDevicePurchasing{
+			PONumber: ptr("PO-1"),
+		},
+	})
+	if err != nil {
+		t.Errorf("MobileDevices.Update(): %v", err)
+	}
+}
@@ -196,7 +196,7 @@ func (s *OSXConfigurationProfilesService) Create(ctx context.Context, osxConfigu
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -209,13 +209,13 @@ func (s *OSXConfigurationProfilesService) Create(ctx context.Context, osxConfigu
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -223,7 +223,7 @@ func (s *OSXConfigurationProfilesService) Create(ctx context.Context, osxConfigu
 		ID      *int     `xml:"id"`
 	}
 	if err := xml.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	// The create API only returns the ID of the new OSXConfigurationProfile.
@@ -241,7 +241,7 @@ func (s *OSXConfigurationProfilesService) Delete(ctx context.Context, osxConfigu
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -258,18 +258,18 @@ func (s *OSXConfigurationProfilesService) Get(ctx context.Context, osxConfigurat
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var osxConfigurationProfile OSXConfigurationProfile
 	if err := xml.Unmarshal(respBody, &osxConfigurationProfile); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &osxConfigurationProfile, resp, nil
@@ -284,18 +284,18 @@ func (s *OSXConfigurationProfilesService) List(ctx context.Context) (*ListOSXCon
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listOSXConfigurationProfiles ListOSXConfigurationProfiles
 	if err := xml.Unmarshal(respBody, &listOSXConfigurationProfiles); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &listOSXConfigurationProfiles, resp, nil
@@ -320,7 +320,7 @@ func (s *OSXConfigurationProfilesService) Update(ctx context.Context, osxConfigu
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -334,7 +334,7 @@ func (s *OSXConfigurationProfilesService) Update(ctx context.Context, osxConfigu
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, fmt.Errorf("client.Put(): %w", err)
 	}
 
 	return resp, nil
@@ -17,26 +17,26 @@ import (
 type PackagesService service
 
 type Package struct {
-	ID                         *int    `xml:"id,omitempty"`
-	Name                       *string `xml:"name,omitempty"`
-	Category                   *string `xml:"category,omitempty"`
-	Filename                   *string `xml:"filename,omitempty"`
-	Info                       *string `xml:"info,omitempty"`
-	Notes                      *string `xml:"notes,omitempty"`
-	Priority                   *int    `xml:"priority,omitempty"`
-	RebootRequired             *bool   `xml:"reboot_required,omitempty"`
-	FillUserTemplate           *bool   `xml:"fill_user_template,omitempty"`
-	FillExistingUsers          *bool   `xml:"fill_existing_users,omitempty"`
-	AllowUninstalled           *bool   `xml:"allow_uninstalled,omitempty"`
-	OSRequirements             *string `xml:"os_requirements,omitempty"`
-	RequiredProcessor          *string `xml:"required_processor,omitempty"`
-	HashType                   *string `xml:"hash_type,omitempty"`
-	HashValue                  *string `xml:"hash_value,omitempty"`
-	SwitchWithPackage          *string `xml:"switch_with_package,omitempty"`
-	InstallIfReportedAvailable *bool   `xml:"install_if_reported_available,omitempty"`
-	ReinstallOption            *string `xml:"reinstall_option,omitempty"`
-	TriggeringFiles            *string `xml:"triggering_files,omitempty"`
-	SendNotification           *bool   `xml:"send_notification,omitempty"`
+	ID                         *int             `xml:"id,omitempty"`
+	Name                       *string          `xml:"name,omitempty"`
+	Category                   *string          `xml:"category,omitempty"`
+	Filename                   *string          `xml:"filename,omitempty"`
+	Info                       *string          `xml:"info,omitempty"`
+	Notes                      *string          `xml:"notes,omitempty"`
+	Priority                   *int             `xml:"priority,omitempty"`
+	RebootRequired             *bool            `xml:"reboot_required,omitempty"`
+	FillUserTemplate           *bool            `xml:"fill_user_template,omitempty"`
+	FillExistingUsers          *bool            `xml:"fill_existing_users,omitempty"`
+	AllowUninstalled           *bool            `xml:"allow_uninstalled,omitempty"`
+	OSRequirements             *string          `xml:"os_requirements,omitempty"`
+	RequiredProcessor          *string          `xml:"required_processor,omitempty"`
+	HashType                   *PackageHashType `xml:"hash_type,omitempty"`
+	HashValue                  *string          `xml:"hash_value,omitempty"`
+	SwitchWithPackage          *string          `xml:"switch_with_package,omitempty"`
+	InstallIfReportedAvailable *bool            `xml:"install_if_reported_available,omitempty"`
+	ReinstallOption            *string          `xml:"reinstall_option,omitempty"`
+	TriggeringFiles            *string          `xml:"triggering_files,omitempty"`
+	SendNotification           *bool            `xml:"send_notification,omitempty"`
 }
 
 type PackageRequiredProcessor string
@@ -47,6 +47,21 @@ const (
 	PackageRequiredProcessorX86  PackageRequiredProcessor = "x86"
 )
 
+type PackageHashType string
+
+const (
+	PackageHashTypeMD5    PackageHashType = jamf.HashTypeMD5
+	PackageHashTypeSHA512 PackageHashType = jamf.HashTypeSHA512
+)
+
+// SetChecksum sets the SHA-512 of the checksum as the hash of the package, so that Jamf Pro can verify it on devices.
+func (p *Package) SetChecksum(checksum *jamf.Checksum) {
+	hashType := PackageHashTypeSHA512
+	hashValue := checksum.SHA512
+	p.HashType = &hashType
+	p.HashValue = &hashValue
+}
+
 type ListPackages struct {
 	Size     *int           `xml:"size,omitempty"`
 	Packages *[]ListPackage `xml:"package,omitempty"`
@@ -78,7 +93,7 @@ func (s *PackagesService) Create(ctx context.Context, pkg *Package) (*int, *jamf
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -91,13 +106,13 @@ func (s *PackagesService) Create(ctx context.Context, pkg *Package) (*int, *jamf
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -105,7 +120,7 @@ func (s *PackagesService) Create(ctx context.Context, pkg *Package) (*int, *jamf
 		ID      *int     `xml:"id"`
 	}
 	if err := xml.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	// The create API only returns the ID of the new Package.
@@ -123,7 +138,7 @@ func (s *PackagesService) Delete(ctx context.Context, packageID int) (*jamf.Resp
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -140,18 +155,18 @@ func (s *PackagesService) Get(ctx context.Context, packageID int) (*Package, *ja
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var pkg Package
 	if err := xml.Unmarshal(respBody, &pkg); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &pkg, resp, nil
@@ -166,18 +181,18 @@ func (s *PackagesService) List(ctx context.Context) (*ListPackages, *jamf.Respon
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listPackages ListPackages
 	if err := xml.Unmarshal(respBody, &listPackages); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &listPackages, resp, nil
@@ -196,7 +211,7 @@ func (s *PackagesService) Update(ctx context.Context, pkg *Package) (*jamf.Respo
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -210,7 +225,29 @@ func (s *PackagesService) Update(ctx context.Context, pkg *Package) (*jamf.Respo
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// VerifyChecksum compares the checksum of a local file with the hash of the package on the server.
+// It returns jamf.ErrChecksumNotFound when the package has no hash, and a *jamf.ChecksumMismatchError when they differ.
+func (s *PackagesService) VerifyChecksum(ctx context.Context, packageID int, checksum *jamf.Checksum) (*jamf.Response, error) {
+	pkg, resp, err := s.Get(ctx, packageID)
+	if err != nil {
+		return resp, err
+	}
+
+	var hashType, hashValue string
+	if pkg.HashType != nil {
+		hashType = string(*pkg.HashType)
+	}
+	if pkg.HashValue != nil {
+		hashValue = *pkg.HashValue
+	}
+	if err := checksum.Verify(hashType, hashValue); err != nil {
+		return resp, fmt.Errorf("PackagesService.VerifyChecksum(): %w", err)
 	}
 
 	return resp, nil
@@ -2,11 +2,14 @@ package classic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
 )
 
 func TestPackagesService_Create(t *testing.T) {
@@ -115,7 +118,7 @@ func TestPackagesService_Get(t *testing.T) {
 		AllowUninstalled:           ptr(false),
 		OSRequirements:             ptr(""),
 		RequiredProcessor:          ptr("None"),
-		HashType:                   ptr("MD5"),
+		HashType:                   ptr(PackageHashTypeMD5),
 		HashValue:                  ptr("05133a2170431a3cb50d76607ab0a1cc"),
 		SwitchWithPackage:          ptr("Do Not Install"),
 		InstallIfReportedAvailable: ptr(false),
@@ -188,3 +191,44 @@ func TestPackagesService_Update(t *testing.T) {
 		t.Errorf("Packages.Update(): %v", err)
 	}
 }
+
+func TestPackagesService_VerifyChecksum(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := 1
+	mux.HandleFunc(buildHandlePath(packagesPath, "id", fmt.Sprint(packageID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<package>
+  <id>1</id>
+  <name>Test Package</name>
+  <filename>test.pkg</filename>
+  <hash_type>SHA_512</hash_type>
+  <hash_value>ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f</hash_value>
+</package>`))
+	})
+
+	checksum, err := jamf.ComputeChecksum(strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("jamf.ComputeChecksum(): %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Packages.VerifyChecksum(ctx, packageID, checksum); err != nil {
+		t.Errorf("Packages.VerifyChecksum(): %v", err)
+	}
+
+	checksum, err = jamf.ComputeChecksum(strings.NewReader("abd"))
+	if err != nil {
+		t.Fatalf("jamf.ComputeChecksum(): %v", err)
+	}
+
+	_, err = client.Packages.VerifyChecksum(ctx, packageID, checksum)
+	var mismatchErr *jamf.ChecksumMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Errorf("Packages.VerifyChecksum() returned %v, want *jamf.ChecksumMismatchError", err)
+	}
+}
@@ -0,0 +1,325 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type PatchPoliciesService service
+
+type ListPatchPolicies struct {
+	Size          *int               `xml:"size,omitempty"`
+	PatchPolicies *[]ListPatchPolicy `xml:"patch_policy,omitempty"`
+}
+
+type ListPatchPolicy struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type PatchPolicy struct {
+	General                      *PatchPolicyGeneral         `xml:"general,omitempty"`
+	Scope                        *PatchPolicyScope           `xml:"scope,omitempty"`
+	UserInteraction              *PatchPolicyUserInteraction `xml:"user_interaction,omitempty"`
+	SoftwareTitleConfigurationID *int                        `xml:"software_title_configuration_id,omitempty"`
+}
+
+type PatchPolicyGeneral struct {
+	ID                 *int                                  `xml:"id,omitempty"`
+	Name               *string                               `xml:"name,omitempty"`
+	Enabled            *bool                                 `xml:"enabled,omitempty"`
+	TargetVersion      *string                               `xml:"target_version,omitempty"`
+	ReleaseDate        *string                               `xml:"release_date,omitempty"`
+	IncrementalUpdates *bool                                 `xml:"incremental_updates,omitempty"`
+	Reboot             *bool                                 `xml:"reboot,omitempty"`
+	MinimumOS          *string                               `xml:"minimum_os,omitempty"`
+	KillApps           *[]PatchPolicyGeneralKillApp          `xml:"kill_apps>kill_app,omitempty"`
+	DistributionMethod *PatchPolicyGeneralDistributionMethod `xml:"distribution_method,omitempty"`
+	AllowDowngrade     *bool                                 `xml:"allow_downgrade,omitempty"`
+	PatchUnknown       *bool                                 `xml:"patch_unknown,omitempty"`
+}
+
+type PatchPolicyGeneralKillApp struct {
+	KillAppName     *string `xml:"kill_app_name,omitempty"`
+	KillAppBundleID *string `xml:"kill_app_bundle_id,omitempty"`
+}
+
+type PatchPolicyGeneralDistributionMethod string
+
+const (
+	PatchPolicyGeneralDistributionMethodPrompt      PatchPolicyGeneralDistributionMethod = "prompt"
+	PatchPolicyGeneralDistributionMethodSelfService PatchPolicyGeneralDistributionMethod = "selfservice"
+)
+
+type PatchPolicyScope struct {
+	AllComputers   *bool                            `xml:"all_computers,omitempty"`
+	Computers      *[]PatchPolicyScopeComputer      `xml:"computers>computer,omitempty"`
+	ComputerGroups *[]PatchPolicyScopeComputerGroup `xml:"computer_groups>computer_group,omitempty"`
+	Buildings      *[]Building                      `xml:"buildings>building,omitempty"`
+	Departments    *[]Department                    `xml:"departments>department,omitempty"`
+	Limitations    *PatchPolicyScopeLimitations     `xml:"limitations,omitempty"`
+	Exclusions     *PatchPolicyScopeExclusions      `xml:"exclusions,omitempty"`
+}
+
+type PatchPolicyScopeExclusions struct {
+	Computers       *[]PatchPolicyScopeComputer       `xml:"computers>computer,omitempty"`
+	ComputerGroups  *[]PatchPolicyScopeComputerGroup  `xml:"computer_groups>computer_group,omitempty"`
+	Buildings       *[]Building                       `xml:"buildings>building,omitempty"`
+	Departments     *[]Department                     `xml:"departments>department,omitempty"`
+	NetworkSegments *[]PatchPolicyScopeNetworkSegment `xml:"network_segments>network_segment,omitempty"`
+	Ibeacons        *[]PatchPolicyScopeIbeacon        `xml:"ibeacons>ibeacon,omitempty"`
+}
+
+type PatchPolicyScopeLimitations struct {
+	NetworkSegments *[]PatchPolicyScopeNetworkSegment `xml:"network_segments>network_segment,omitempty"`
+	Ibeacons        *[]PatchPolicyScopeIbeacon        `xml:"ibeacons>ibeacon,omitempty"`
+}
+
+type PatchPolicyScopeComputer struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+	UDID *string `xml:"udid,omitempty"`
+}
+
+type PatchPolicyScopeComputerGroup struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type PatchPolicyScopeIbeacon struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type PatchPolicyScopeNetworkSegment struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type PatchPolicyUserInteraction struct {
+	InstallButtonText      *string                                  `xml:"install_button_text,omitempty"`
+	SelfServiceDescription *string                                  `xml:"self_service_description,omitempty"`
+	SelfServiceIcon        *Icon                                    `xml:"self_service_icon,omitempty"`
+	Notifications          *PatchPolicyUserInteractionNotifications `xml:"notifications,omitempty"`
+	Deadlines              *PatchPolicyUserInteractionDeadlines     `xml:"deadlines,omitempty"`
+	GracePeriod            *PatchPolicyUserInteractionGracePeriod   `xml:"grace_period,omitempty"`
+}
+
+type PatchPolicyUserInteractionNotifications struct {
+	NotificationEnabled *bool                                             `xml:"notification_enabled,omitempty"`
+	NotificationType    *PatchPolicyUserInteractionNotificationType       `xml:"notification_type,omitempty"`
+	NotificationSubject *string                                           `xml:"notification_subject,omitempty"`
+	NotificationMessage *string                                           `xml:"notification_message,omitempty"`
+	Reminders           *PatchPolicyUserInteractionNotificationsReminders `xml:"reminders,omitempty"`
+}
+
+type PatchPolicyUserInteractionNotificationType string
+
+const (
+	PatchPolicyUserInteractionNotificationTypeSelfService                      PatchPolicyUserInteractionNotificationType = "Self Service"
+	PatchPolicyUserInteractionNotificationTypeSelfServiceAndNotificationCenter PatchPolicyUserInteractionNotificationType = "Self Service and Notification Center"
+)
+
+type PatchPolicyUserInteractionNotificationsReminders struct {
+	NotificationRemindersEnabled  *bool `xml:"notification_reminders_enabled,omitempty"`
+	NotificationReminderFrequency *int  `xml:"notification_reminder_frequency,omitempty"`
+}
+
+type PatchPolicyUserInteractionDeadlines struct {
+	DeadlineEnabled *bool `xml:"deadline_enabled,omitempty"`
+	// DeadlinePeriod is the number of days until the update is installed automatically.
+	DeadlinePeriod *int `xml:"deadline_period,omitempty"`
+}
+
+type PatchPolicyUserInteractionGracePeriod struct {
+	// GracePeriodDuration is the number of minutes until the apps are quit to install the update.
+	GracePeriodDuration       *int    `xml:"grace_period_duration,omitempty"`
+	NotificationCenterSubject *string `xml:"notification_center_subject,omitempty"`
+	Message                   *string `xml:"message,omitempty"`
+}
+
+const patchPoliciesPath = "/patchpolicies"
+
+// Create creates a patch policy of the software title configuration, i.e. the ID of the PatchSoftwareTitle.
+func (s *PatchPoliciesService) Create(ctx context.Context, softwareTitleConfigurationID int, patchPolicy *PatchPolicy) (*int, *jamf.Response, error) {
+	if patchPolicy == nil {
+		return nil, nil, errors.New("PatchPoliciesService.Create(): cannot create nil patch policy")
+	}
+	if patchPolicy.General == nil {
+		return nil, nil, errors.New("PatchPoliciesService.Create(): cannot create patch policy with nil General")
+	}
+	if patchPolicy.General.Name == nil {
+		return nil, nil, errors.New("PatchPoliciesService.Create(): cannot create patch policy with nil Name of General")
+	}
+	if patchPolicy.General.TargetVersion == nil {
+		return nil, nil, errors.New("PatchPoliciesService.Create(): cannot create patch policy with nil TargetVersion of General")
+	}
+
+	reqBody := &struct {
+		*PatchPolicy
+		XMLName xml.Name `xml:"patch_policy"`
+	}{
+		PatchPolicy: patchPolicy,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchPoliciesPath, "softwaretitleconfig", "id", fmt.Sprint(softwareTitleConfigurationID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"patch_policy"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new PatchPolicy.
+	return data.ID, resp, nil
+}
+
+func (s *PatchPoliciesService) Delete(ctx context.Context, patchPolicyID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchPoliciesPath, "id", fmt.Sprint(patchPolicyID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatchPoliciesService) Get(ctx context.Context, patchPolicyID int) (*PatchPolicy, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchPoliciesPath, "id", fmt.Sprint(patchPolicyID)),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var patchPolicy PatchPolicy
+	if err := xml.Unmarshal(respBody, &patchPolicy); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &patchPolicy, resp, nil
+}
+
+func (s *PatchPoliciesService) List(ctx context.Context) (*ListPatchPolicies, *jamf.Response, error) {
+	return s.list(ctx, patchPoliciesPath)
+}
+
+// ListBySoftwareTitleConfiguration returns the patch policies of the software title configuration.
+func (s *PatchPoliciesService) ListBySoftwareTitleConfiguration(ctx context.Context, softwareTitleConfigurationID int) (*ListPatchPolicies, *jamf.Response, error) {
+	return s.list(ctx, path.Join(patchPoliciesPath, "softwaretitleconfig", "id", fmt.Sprint(softwareTitleConfigurationID)))
+}
+
+func (s *PatchPoliciesService) list(ctx context.Context, entity string) (*ListPatchPolicies, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listPatchPolicies ListPatchPolicies
+	if err := xml.Unmarshal(respBody, &listPatchPolicies); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listPatchPolicies, resp, nil
+}
+
+func (s *PatchPoliciesService) Update(ctx context.Context, patchPolicy *PatchPolicy) (*jamf.Response, error) {
+	if patchPolicy == nil {
+		return nil, errors.New("PatchPoliciesService.Update(): cannot update nil patch policy")
+	}
+	if patchPolicy.General == nil {
+		return nil, errors.New("PatchPoliciesService.Update(): cannot update patch policy with nil General")
+	}
+	if patchPolicy.General.ID == nil {
+		return nil, errors.New("PatchPoliciesService.Update(): cannot update patch policy with nil ID of General")
+	}
+
+	reqBody := &struct {
+		*PatchPolicy
+		XMLName xml.Name `xml:"patch_policy"`
+	}{
+		PatchPolicy: patchPolicy,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchPoliciesPath, "id", fmt.Sprint(*patchPolicy.General.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
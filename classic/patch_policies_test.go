@@ -0,0 +1,334 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPatchPoliciesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath, "softwaretitleconfig", "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<patch_policy><general><name>Google Chrome 124.0</name><enabled>true</enabled><target_version>124.0</target_version><distribution_method>prompt</distribution_method></general><scope><computer_groups><computer_group><id>1</id></computer_group></computer_groups></scope><user_interaction><deadlines><deadline_enabled>true</deadline_enabled><deadline_period>7</deadline_period></deadlines></user_interaction></patch_policy>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_policy>
+  <id>1</id>
+</patch_policy>`))
+	})
+
+	ctx := context.Background()
+	patchPolicyID, _, err := client.PatchPolicies.Create(ctx, 1, &PatchPolicy{
+		General: &PatchPolicyGeneral{
+			Name:               ptr("Google Chrome 124.0"),
+			Enabled:            ptr(true),
+			TargetVersion:      ptr("124.0"),
+			DistributionMethod: ptr(PatchPolicyGeneralDistributionMethodPrompt),
+		},
+		Scope: &PatchPolicyScope{
+			ComputerGroups: &[]PatchPolicyScopeComputerGroup{
+				{ID: ptr(1)},
+			},
+		},
+		UserInteraction: &PatchPolicyUserInteraction{
+			Deadlines: &PatchPolicyUserInteractionDeadlines{
+				DeadlineEnabled: ptr(true),
+				DeadlinePeriod:  ptr(7),
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("PatchPolicies.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(patchPolicyID, want) {
+		t.Errorf("PatchPolicies.Create() returned %s, want %s", formatWithSpew(patchPolicyID), formatWithSpew(want))
+	}
+}
+
+func TestPatchPoliciesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_policy>
+  <id>1</id>
+</patch_policy>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchPolicies.Delete(ctx, 1)
+	if err != nil {
+		t.Errorf("PatchPolicies.Delete(): %v", err)
+	}
+}
+
+func TestPatchPoliciesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_policy>
+  <general>
+    <id>1</id>
+    <name>Google Chrome 124.0</name>
+    <enabled>true</enabled>
+    <target_version>124.0</target_version>
+    <release_date>1713295870000</release_date>
+    <incremental_updates>false</incremental_updates>
+    <reboot>false</reboot>
+    <minimum_os>10.15</minimum_os>
+    <kill_apps>
+      <kill_app>
+        <kill_app_name>Google Chrome.app</kill_app_name>
+        <kill_app_bundle_id>com.google.Chrome</kill_app_bundle_id>
+      </kill_app>
+    </kill_apps>
+    <distribution_method>selfservice</distribution_method>
+    <allow_downgrade>false</allow_downgrade>
+    <patch_unknown>true</patch_unknown>
+  </general>
+  <scope>
+    <all_computers>true</all_computers>
+    <limitations>
+      <network_segments>
+        <network_segment>
+          <id>1</id>
+          <name>Office</name>
+        </network_segment>
+      </network_segments>
+    </limitations>
+    <exclusions>
+      <computer_groups>
+        <computer_group>
+          <id>2</id>
+          <name>Kiosks</name>
+        </computer_group>
+      </computer_groups>
+    </exclusions>
+  </scope>
+  <user_interaction>
+    <install_button_text>Update</install_button_text>
+    <self_service_description>Update Google Chrome</self_service_description>
+    <self_service_icon>
+      <id>1</id>
+      <filename>chrome.png</filename>
+      <uri>https://example.jamfcloud.com/icon?id=1</uri>
+    </self_service_icon>
+    <notifications>
+      <notification_enabled>true</notification_enabled>
+      <notification_type>Self Service and Notification Center</notification_type>
+      <notification_subject>Update Available</notification_subject>
+      <notification_message>Google Chrome 124.0 is available.</notification_message>
+      <reminders>
+        <notification_reminders_enabled>true</notification_reminders_enabled>
+        <notification_reminder_frequency>1</notification_reminder_frequency>
+      </reminders>
+    </notifications>
+    <deadlines>
+      <deadline_enabled>true</deadline_enabled>
+      <deadline_period>7</deadline_period>
+    </deadlines>
+    <grace_period>
+      <grace_period_duration>15</grace_period_duration>
+      <notification_center_subject>Important</notification_center_subject>
+      <message>$APP_NAMES will quit in $DELAY_MINUTES minutes so that $SOFTWARE_TITLE can be updated.</message>
+    </grace_period>
+  </user_interaction>
+  <software_title_configuration_id>1</software_title_configuration_id>
+</patch_policy>`))
+	})
+
+	ctx := context.Background()
+	patchPolicy, _, err := client.PatchPolicies.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("PatchPolicies.Get(): %v", err)
+	}
+
+	want := &PatchPolicy{
+		General: &PatchPolicyGeneral{
+			ID:                 ptr(1),
+			Name:               ptr("Google Chrome 124.0"),
+			Enabled:            ptr(true),
+			TargetVersion:      ptr("124.0"),
+			ReleaseDate:        ptr("1713295870000"),
+			IncrementalUpdates: ptr(false),
+			Reboot:             ptr(false),
+			MinimumOS:          ptr("10.15"),
+			KillApps: &[]PatchPolicyGeneralKillApp{
+				{
+					KillAppName:     ptr("Google Chrome.app"),
+					KillAppBundleID: ptr("com.google.Chrome"),
+				},
+			},
+			DistributionMethod: ptr(PatchPolicyGeneralDistributionMethodSelfService),
+			AllowDowngrade:     ptr(false),
+			PatchUnknown:       ptr(true),
+		},
+		Scope: &PatchPolicyScope{
+			AllComputers: ptr(true),
+			Limitations: &PatchPolicyScopeLimitations{
+				NetworkSegments: &[]PatchPolicyScopeNetworkSegment{
+					{
+						ID:   ptr(1),
+						Name: ptr("Office"),
+					},
+				},
+			},
+			Exclusions: &PatchPolicyScopeExclusions{
+				ComputerGroups: &[]PatchPolicyScopeComputerGroup{
+					{
+						ID:   ptr(2),
+						Name: ptr("Kiosks"),
+					},
+				},
+			},
+		},
+		UserInteraction: &PatchPolicyUserInteraction{
+			InstallButtonText:      ptr("Update"),
+			SelfServiceDescription: ptr("Update Google Chrome"),
+			SelfServiceIcon: &Icon{
+				ID:       ptr(1),
+				Filename: ptr("chrome.png"),
+				URI:      ptr("https://example.jamfcloud.com/icon?id=1"),
+			},
+			Notifications: &PatchPolicyUserInteractionNotifications{
+				NotificationEnabled: ptr(true),
+				NotificationType:    ptr(PatchPolicyUserInteractionNotificationTypeSelfServiceAndNotificationCenter),
+				NotificationSubject: ptr("Update Available"),
+				NotificationMessage: ptr("Google Chrome 124.0 is available."),
+				Reminders: &PatchPolicyUserInteractionNotificationsReminders{
+					NotificationRemindersEnabled:  ptr(true),
+					NotificationReminderFrequency: ptr(1),
+				},
+			},
+			Deadlines: &PatchPolicyUserInteractionDeadlines{
+				DeadlineEnabled: ptr(true),
+				DeadlinePeriod:  ptr(7),
+			},
+			GracePeriod: &PatchPolicyUserInteractionGracePeriod{
+				GracePeriodDuration:       ptr(15),
+				NotificationCenterSubject: ptr("Important"),
+				Message:                   ptr("$APP_NAMES will quit in $DELAY_MINUTES minutes so that $SOFTWARE_TITLE can be updated."),
+			},
+		},
+		SoftwareTitleConfigurationID: ptr(1),
+	}
+	if !cmp.Equal(patchPolicy, want) {
+		t.Errorf("PatchPolicies.Get() returned %s, want %s", formatWithSpew(patchPolicy), formatWithSpew(want))
+	}
+}
+
+func TestPatchPoliciesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_policies>
+  <size>1</size>
+  <patch_policy>
+    <id>1</id>
+    <name>Google Chrome 124.0</name>
+  </patch_policy>
+</patch_policies>`))
+	})
+
+	ctx := context.Background()
+	patchPolicies, _, err := client.PatchPolicies.List(ctx)
+	if err != nil {
+		t.Fatalf("PatchPolicies.List(): %v", err)
+	}
+
+	want := &ListPatchPolicies{
+		Size: ptr(1),
+		PatchPolicies: &[]ListPatchPolicy{
+			{
+				ID:   ptr(1),
+				Name: ptr("Google Chrome 124.0"),
+			},
+		},
+	}
+	if !cmp.Equal(patchPolicies, want) {
+		t.Errorf("PatchPolicies.List() returned %s, want %s", formatWithSpew(patchPolicies), formatWithSpew(want))
+	}
+}
+
+func TestPatchPoliciesService_ListBySoftwareTitleConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath, "softwaretitleconfig", "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_policies>
+  <size>1</size>
+  <patch_policy>
+    <id>1</id>
+    <name>Google Chrome 124.0</name>
+  </patch_policy>
+</patch_policies>`))
+	})
+
+	ctx := context.Background()
+	patchPolicies, _, err := client.PatchPolicies.ListBySoftwareTitleConfiguration(ctx, 1)
+	if err != nil {
+		t.Fatalf("PatchPolicies.ListBySoftwareTitleConfiguration(): %v", err)
+	}
+
+	want := &ListPatchPolicies{
+		Size: ptr(1),
+		PatchPolicies: &[]ListPatchPolicy{
+			{
+				ID:   ptr(1),
+				Name: ptr("Google Chrome 124.0"),
+			},
+		},
+	}
+	if !cmp.Equal(patchPolicies, want) {
+		t.Errorf("PatchPolicies.ListBySoftwareTitleConfiguration() returned %s, want %s", formatWithSpew(patchPolicies), formatWithSpew(want))
+	}
+}
+
+func TestPatchPoliciesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchPoliciesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<patch_policy><general><id>1</id><target_version>125.0</target_version></general></patch_policy>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchPolicies.Update(ctx, &PatchPolicy{
+		General: &PatchPolicyGeneral{
+			ID:            ptr(1),
+			TargetVersion: ptr("125.0"),
+		},
+	})
+	if err != nil {
+		t.Errorf("PatchPolicies.Update(): %v", err)
+	}
+}
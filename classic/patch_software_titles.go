@@ -0,0 +1,255 @@
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type PatchSoftwareTitlesService service
+
+type ListPatchSoftwareTitles struct {
+	Size                *int                      `xml:"size,omitempty"`
+	PatchSoftwareTitles *[]ListPatchSoftwareTitle `xml:"patch_software_title,omitempty"`
+}
+
+type ListPatchSoftwareTitle struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+type PatchSoftwareTitle struct {
+	ID            *int                             `xml:"id,omitempty"`
+	Name          *string                          `xml:"name,omitempty"`
+	NameID        *string                          `xml:"name_id,omitempty"`
+	SourceID      *int                             `xml:"source_id,omitempty"`
+	Notifications *PatchSoftwareTitleNotifications `xml:"notifications,omitempty"`
+	Category      *GeneralCategory                 `xml:"category,omitempty"`
+	Site          *Site                            `xml:"site,omitempty"`
+	Versions      *[]PatchSoftwareTitleVersion     `xml:"versions>version,omitempty"`
+}
+
+type PatchSoftwareTitleNotifications struct {
+	WebNotification   *bool `xml:"web_notification,omitempty"`
+	EmailNotification *bool `xml:"email_notification,omitempty"`
+}
+
+// PatchSoftwareTitleVersion maps a version of the software title to a package.
+type PatchSoftwareTitleVersion struct {
+	SoftwareVersion *string                           `xml:"software_version,omitempty"`
+	Package         *PatchSoftwareTitleVersionPackage `xml:"package,omitempty"`
+}
+
+type PatchSoftwareTitleVersionPackage struct {
+	ID   *int    `xml:"id,omitempty"`
+	Name *string `xml:"name,omitempty"`
+}
+
+// SetPackage maps the version to the package, replacing the package which is already mapped to the version.
+// It does nothing when pkg is nil.
+func (t *PatchSoftwareTitle) SetPackage(version string, pkg *Package) {
+	if pkg == nil {
+		return
+	}
+
+	mapping := PatchSoftwareTitleVersion{
+		SoftwareVersion: &version,
+		Package: &PatchSoftwareTitleVersionPackage{
+			ID:   pkg.ID,
+			Name: pkg.Name,
+		},
+	}
+	if t.Versions == nil {
+		t.Versions = &[]PatchSoftwareTitleVersion{}
+	}
+	for i, v := range *t.Versions {
+		if v.SoftwareVersion != nil && *v.SoftwareVersion == version {
+			(*t.Versions)[i] = mapping
+			return
+		}
+	}
+	*t.Versions = append(*t.Versions, mapping)
+}
+
+// PackageID returns the ID of the package which is mapped to the version, or nil when no package is mapped.
+func (t *PatchSoftwareTitle) PackageID(version string) *int {
+	if t.Versions == nil {
+		return nil
+	}
+	for _, v := range *t.Versions {
+		if v.SoftwareVersion != nil && *v.SoftwareVersion == version && v.Package != nil {
+			return v.Package.ID
+		}
+	}
+	return nil
+}
+
+const patchSoftwareTitlesPath = "/patchsoftwaretitles"
+
+func (s *PatchSoftwareTitlesService) Create(ctx context.Context, patchSoftwareTitle *PatchSoftwareTitle) (*int, *jamf.Response, error) {
+	if patchSoftwareTitle == nil {
+		return nil, nil, errors.New("PatchSoftwareTitlesService.Create(): cannot create nil patch software title")
+	}
+	if patchSoftwareTitle.NameID == nil {
+		return nil, nil, errors.New("PatchSoftwareTitlesService.Create(): cannot create patch software title with nil NameID")
+	}
+	if patchSoftwareTitle.SourceID == nil {
+		return nil, nil, errors.New("PatchSoftwareTitlesService.Create(): cannot create patch software title with nil SourceID")
+	}
+
+	reqBody := &struct {
+		*PatchSoftwareTitle
+		XMLName xml.Name `xml:"patch_software_title"`
+	}{
+		PatchSoftwareTitle: patchSoftwareTitle,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			// When the ID is 0, the ID will be generated automatically at the server side.
+			Entity: path.Join(patchSoftwareTitlesPath, "id", "0"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		XMLName xml.Name `xml:"patch_software_title"`
+		ID      *int     `xml:"id"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	// The create API only returns the ID of the new PatchSoftwareTitle.
+	return data.ID, resp, nil
+}
+
+func (s *PatchSoftwareTitlesService) Delete(ctx context.Context, patchSoftwareTitleID int) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitlesPath, "id", fmt.Sprint(patchSoftwareTitleID)),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatchSoftwareTitlesService) Get(ctx context.Context, patchSoftwareTitleID int) (*PatchSoftwareTitle, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitlesPath, "id", fmt.Sprint(patchSoftwareTitleID)),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var patchSoftwareTitle PatchSoftwareTitle
+	if err := xml.Unmarshal(respBody, &patchSoftwareTitle); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &patchSoftwareTitle, resp, nil
+}
+
+func (s *PatchSoftwareTitlesService) List(ctx context.Context) (*ListPatchSoftwareTitles, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: patchSoftwareTitlesPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listPatchSoftwareTitles ListPatchSoftwareTitles
+	if err := xml.Unmarshal(respBody, &listPatchSoftwareTitles); err != nil {
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return &listPatchSoftwareTitles, resp, nil
+}
+
+func (s *PatchSoftwareTitlesService) Update(ctx context.Context, patchSoftwareTitle *PatchSoftwareTitle) (*jamf.Response, error) {
+	if patchSoftwareTitle == nil {
+		return nil, errors.New("PatchSoftwareTitlesService.Update(): cannot update nil patch software title")
+	}
+	if patchSoftwareTitle.ID == nil {
+		return nil, errors.New("PatchSoftwareTitlesService.Update(): cannot update patch software title with nil ID")
+	}
+
+	reqBody := &struct {
+		*PatchSoftwareTitle
+		XMLName xml.Name `xml:"patch_software_title"`
+	}{
+		PatchSoftwareTitle: patchSoftwareTitle,
+	}
+	body, err := xml.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		// The API may return a 404, e.g., when a resource is just created.
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitlesPath, "id", fmt.Sprint(*patchSoftwareTitle.ID)),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Put(): %w", err)
+	}
+
+	return resp, nil
+}
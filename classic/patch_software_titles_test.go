@@ -0,0 +1,245 @@
+package classic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPatchSoftwareTitle_SetPackage(t *testing.T) {
+	patchSoftwareTitle := &PatchSoftwareTitle{
+		Versions: &[]PatchSoftwareTitleVersion{
+			{SoftwareVersion: ptr("124.0")},
+			{
+				SoftwareVersion: ptr("123.0"),
+				Package:         &PatchSoftwareTitleVersionPackage{ID: ptr(1), Name: ptr("Chrome-123.0.pkg")},
+			},
+		},
+	}
+
+	patchSoftwareTitle.SetPackage("124.0", &Package{ID: ptr(2), Name: ptr("Chrome-124.0.pkg")})
+	patchSoftwareTitle.SetPackage("125.0", &Package{ID: ptr(3), Name: ptr("Chrome-125.0.pkg")})
+	patchSoftwareTitle.SetPackage("126.0", nil)
+
+	want := &[]PatchSoftwareTitleVersion{
+		{
+			SoftwareVersion: ptr("124.0"),
+			Package:         &PatchSoftwareTitleVersionPackage{ID: ptr(2), Name: ptr("Chrome-124.0.pkg")},
+		},
+		{
+			SoftwareVersion: ptr("123.0"),
+			Package:         &PatchSoftwareTitleVersionPackage{ID: ptr(1), Name: ptr("Chrome-123.0.pkg")},
+		},
+		{
+			SoftwareVersion: ptr("125.0"),
+			Package:         &PatchSoftwareTitleVersionPackage{ID: ptr(3), Name: ptr("Chrome-125.0.pkg")},
+		},
+	}
+	if !cmp.Equal(patchSoftwareTitle.Versions, want) {
+		t.Errorf("PatchSoftwareTitle.SetPackage() set %s, want %s", formatWithSpew(patchSoftwareTitle.Versions), formatWithSpew(want))
+	}
+
+	if got := patchSoftwareTitle.PackageID("123.0"); !cmp.Equal(got, ptr(1)) {
+		t.Errorf("PatchSoftwareTitle.PackageID() returned %s, want %s", formatWithSpew(got), formatWithSpew(ptr(1)))
+	}
+	if got := patchSoftwareTitle.PackageID("122.0"); got != nil {
+		t.Errorf("PatchSoftwareTitle.PackageID() returned %s, want nil", formatWithSpew(got))
+	}
+}
+
+func TestPatchSoftwareTitlesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitlesPath, "id", "0"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("<patch_software_title><name>Google Chrome</name><name_id>GoogleChrome</name_id><source_id>1</source_id></patch_software_title>"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_software_title>
+  <id>1</id>
+</patch_software_title>`))
+	})
+
+	ctx := context.Background()
+	patchSoftwareTitleID, _, err := client.PatchSoftwareTitles.Create(ctx, &PatchSoftwareTitle{
+		Name:     ptr("Google Chrome"),
+		NameID:   ptr("GoogleChrome"),
+		SourceID: ptr(1),
+	})
+	if err != nil {
+		t.Errorf("PatchSoftwareTitles.Create(): %v", err)
+	}
+
+	want := ptr(1)
+	if !cmp.Equal(patchSoftwareTitleID, want) {
+		t.Errorf("PatchSoftwareTitles.Create() returned %s, want %s", formatWithSpew(patchSoftwareTitleID), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitlesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitlesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_software_title>
+  <id>1</id>
+</patch_software_title>`))
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchSoftwareTitles.Delete(ctx, 1)
+	if err != nil {
+		t.Errorf("PatchSoftwareTitles.Delete(): %v", err)
+	}
+}
+
+func TestPatchSoftwareTitlesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitlesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_software_title>
+  <id>1</id>
+  <name>Google Chrome</name>
+  <name_id>GoogleChrome</name_id>
+  <source_id>1</source_id>
+  <notifications>
+    <web_notification>true</web_notification>
+    <email_notification>false</email_notification>
+  </notifications>
+  <category>
+    <id>-1</id>
+    <name>No category assigned</name>
+  </category>
+  <site>
+    <id>-1</id>
+    <name>None</name>
+  </site>
+  <versions>
+    <version>
+      <software_version>124.0</software_version>
+      <package>
+        <id>2</id>
+        <name>Chrome-124.0.pkg</name>
+      </package>
+    </version>
+    <version>
+      <software_version>123.0</software_version>
+      <package/>
+    </version>
+  </versions>
+</patch_software_title>`))
+	})
+
+	ctx := context.Background()
+	patchSoftwareTitle, _, err := client.PatchSoftwareTitles.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitles.Get(): %v", err)
+	}
+
+	want := &PatchSoftwareTitle{
+		ID:       ptr(1),
+		Name:     ptr("Google Chrome"),
+		NameID:   ptr("GoogleChrome"),
+		SourceID: ptr(1),
+		Notifications: &PatchSoftwareTitleNotifications{
+			WebNotification:   ptr(true),
+			EmailNotification: ptr(false),
+		},
+		Category: &GeneralCategory{
+			ID:   ptr(-1),
+			Name: ptr("No category assigned"),
+		},
+		Site: &Site{
+			ID:   ptr(-1),
+			Name: ptr("None"),
+		},
+		Versions: &[]PatchSoftwareTitleVersion{
+			{
+				SoftwareVersion: ptr("124.0"),
+				Package: &PatchSoftwareTitleVersionPackage{
+					ID:   ptr(2),
+					Name: ptr("Chrome-124.0.pkg"),
+				},
+			},
+			{
+				SoftwareVersion: ptr("123.0"),
+				Package:         &PatchSoftwareTitleVersionPackage{},
+			},
+		},
+	}
+	if !cmp.Equal(patchSoftwareTitle, want) {
+		t.Errorf("PatchSoftwareTitles.Get() returned %s, want %s", formatWithSpew(patchSoftwareTitle), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitlesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitlesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<patch_software_titles>
+  <size>1</size>
+  <patch_software_title>
+    <id>1</id>
+    <name>Google Chrome</name>
+  </patch_software_title>
+</patch_software_titles>`))
+	})
+
+	ctx := context.Background()
+	patchSoftwareTitles, _, err := client.PatchSoftwareTitles.List(ctx)
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitles.List(): %v", err)
+	}
+
+	want := &ListPatchSoftwareTitles{
+		Size: ptr(1),
+		PatchSoftwareTitles: &[]ListPatchSoftwareTitle{
+			{
+				ID:   ptr(1),
+				Name: ptr("Google Chrome"),
+			},
+		},
+	}
+	if !cmp.Equal(patchSoftwareTitles, want) {
+		t.Errorf("PatchSoftwareTitles.List() returned %s, want %s", formatWithSpew(patchSoftwareTitles), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitlesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitlesPath, "id", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte("<patch_software_title><id>1</id><versions><version><software_version>124.0</software_version><package><id>2</id><name>Chrome-124.0.pkg</name></package></version></versions></patch_software_title>"))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	patchSoftwareTitle := &PatchSoftwareTitle{ID: ptr(1)}
+	patchSoftwareTitle.SetPackage("124.0", &Package{ID: ptr(2), Name: ptr("Chrome-124.0.pkg")})
+
+	ctx := context.Background()
+	_, err := client.PatchSoftwareTitles.Update(ctx, patchSoftwareTitle)
+	if err != nil {
+		t.Errorf("PatchSoftwareTitles.Update(): %v", err)
+	}
+}
@@ -243,7 +243,7 @@ func (pgdtl *PolicyGeneralDateTimeLimitations) MarshalXML(e *xml.Encoder, start
 	}
 
 	if err := e.EncodeElement(aux, start); err != nil {
-		return fmt.Errorf("xml.Encoder#EncodeElement(): %v", err)
+		return fmt.Errorf("xml.Encoder#EncodeElement(): %w", err)
 	}
 
 	return nil
@@ -260,7 +260,7 @@ func (pgdtl *PolicyGeneralDateTimeLimitations) UnmarshalXML(d *xml.Decoder, star
 	}{Alias: Alias(*pgdtl)}
 
 	if err := d.DecodeElement(&aux, &start); err != nil {
-		return fmt.Errorf("xml.Decoder#DecodeElement(): %v", err)
+		return fmt.Errorf("xml.Decoder#DecodeElement(): %w", err)
 	}
 
 	*pgdtl = PolicyGeneralDateTimeLimitations(aux.Alias)
@@ -268,14 +268,14 @@ func (pgdtl *PolicyGeneralDateTimeLimitations) UnmarshalXML(d *xml.Decoder, star
 	if aux.ActivationDate != "" {
 		parsed, err := time.Parse(time.DateTime, aux.ActivationDate)
 		if err != nil {
-			return fmt.Errorf("time.Parse(): %v", err)
+			return fmt.Errorf("time.Parse(): %w", err)
 		}
 		pgdtl.ActivationDate = &parsed
 	}
 	if aux.ExpirationDate != "" {
 		parsed, err := time.Parse(time.DateTime, aux.ExpirationDate)
 		if err != nil {
-			return fmt.Errorf("time.Parse(): %v", err)
+			return fmt.Errorf("time.Parse(): %w", err)
 		}
 		pgdtl.ExpirationDate = &parsed
 	}
@@ -285,7 +285,7 @@ func (pgdtl *PolicyGeneralDateTimeLimitations) UnmarshalXML(d *xml.Decoder, star
 	if aux.ActivationDateUTC != "" {
 		parsed, err := time.Parse(dateUTCLayout, aux.ActivationDateUTC)
 		if err != nil {
-			return fmt.Errorf("time.Parse(): %v", err)
+			return fmt.Errorf("time.Parse(): %w", err)
 		}
 		if parsed.Location() != time.UTC {
 			parsed = parsed.UTC()
@@ -295,7 +295,7 @@ func (pgdtl *PolicyGeneralDateTimeLimitations) UnmarshalXML(d *xml.Decoder, star
 	if aux.ExpirationDateUTC != "" {
 		parsed, err := time.Parse(dateUTCLayout, aux.ExpirationDateUTC)
 		if err != nil {
-			return fmt.Errorf("time.Parse(): %v", err)
+			return fmt.Errorf("time.Parse(): %w", err)
 		}
 		if parsed.Location() != time.UTC {
 			parsed = parsed.UTC()
@@ -570,7 +570,7 @@ func (ss *PolicySelfService) MarshalXML(e *xml.Encoder, start xml.StartElement)
 	}
 
 	if err := e.EncodeElement(aux, start); err != nil {
-		return fmt.Errorf("xml.Encoder#EncodeElement(): %v", err)
+		return fmt.Errorf("xml.Encoder#EncodeElement(): %w", err)
 	}
 
 	return nil
@@ -584,7 +584,7 @@ func (ss *PolicySelfService) UnmarshalXML(d *xml.Decoder, start xml.StartElement
 	}{Alias: Alias(*ss)}
 
 	if err := d.DecodeElement(&aux, &start); err != nil {
-		return fmt.Errorf("xml.Decoder#DecodeElement(): %v", err)
+		return fmt.Errorf("xml.Decoder#DecodeElement(): %w", err)
 	}
 
 	*ss = PolicySelfService(aux.Alias)
@@ -640,7 +640,7 @@ func (pui *PolicyUserInteraction) MarshalXML(e *xml.Encoder, start xml.StartElem
 	}
 
 	if err := e.EncodeElement(aux, start); err != nil {
-		return fmt.Errorf("xml.Encoder#EncodeElement(): %v", err)
+		return fmt.Errorf("xml.Encoder#EncodeElement(): %w", err)
 	}
 
 	return nil
@@ -654,7 +654,7 @@ func (pui *PolicyUserInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartEl
 	}{Alias: Alias(*pui)}
 
 	if err := d.DecodeElement(&aux, &start); err != nil {
-		return fmt.Errorf("xml.Decoder#DecodeElement(): %v", err)
+		return fmt.Errorf("xml.Decoder#DecodeElement(): %w", err)
 	}
 
 	*pui = PolicyUserInteraction(aux.Alias)
@@ -663,7 +663,7 @@ func (pui *PolicyUserInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartEl
 		dateUTCLayout := "2006-01-02T15:04:05.000-0700"
 		parsed, err := time.Parse(dateUTCLayout, aux.AllowDeferralUntilUTC)
 		if err != nil {
-			return fmt.Errorf("time.Parse(): %v", err)
+			return fmt.Errorf("time.Parse(): %w", err)
 		}
 		if parsed.Location() != time.UTC {
 			parsed = parsed.UTC()
@@ -692,7 +692,7 @@ func (s *PoliciesService) Create(ctx context.Context, policy *Policy) (*int, *ja
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -705,13 +705,13 @@ func (s *PoliciesService) Create(ctx context.Context, policy *Policy) (*int, *ja
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -719,7 +719,7 @@ func (s *PoliciesService) Create(ctx context.Context, policy *Policy) (*int, *ja
 		ID      *int     `xml:"id"`
 	}
 	if err := xml.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	// The create API only returns the ID of the new policy.
@@ -737,7 +737,7 @@ func (s *PoliciesService) Delete(ctx context.Context, policyID int) (*jamf.Respo
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -754,18 +754,18 @@ func (s *PoliciesService) Get(ctx context.Context, policyID int) (*Policy, *jamf
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var policy Policy
 	if err := xml.Unmarshal(respBody, &policy); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &policy, resp, nil
@@ -780,18 +780,18 @@ func (s *PoliciesService) List(ctx context.Context) (*ListPolicies, *jamf.Respon
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listPolicies ListPolicies
 	if err := xml.Unmarshal(respBody, &listPolicies); err != nil {
-		return nil, resp, fmt.Errorf("xml.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("xml.Unmarshal(): %w", err)
 	}
 
 	return &listPolicies, resp, nil
@@ -816,7 +816,7 @@ func (s *PoliciesService) Update(ctx context.Context, policy *Policy) (*jamf.Res
 	}
 	body, err := xml.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("xml.Marshal(): %v", err)
+		return nil, fmt.Errorf("xml.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -830,7 +830,7 @@ func (s *PoliciesService) Update(ctx context.Context, policy *Policy) (*jamf.Res
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, fmt.Errorf("client.Put(): %w", err)
 	}
 
 	return resp, nil
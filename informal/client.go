@@ -29,14 +29,24 @@ type Client struct {
 	services
 }
 
-func NewClient(serverURL string, username string, password string) (*Client, error) {
+// NewClient returns a client which authenticates with the username and the password.
+// They can be empty when jamf.WithAuthorizer is given, and the ones of the Authorizer are used instead.
+func NewClient(serverURL string, username string, password string, opts ...jamf.ClientOption) (*Client, error) {
 	u, err := url.Parse(serverURL)
 	if err != nil {
-		return nil, fmt.Errorf("url.Parse(): %v", err)
+		return nil, fmt.Errorf("url.Parse(): %w", err)
 	}
 
 	client := jamf.NewBaseClient(u)
 	client.DefaultContentType = "application/json; charset=utf-8"
+	client.APIFamily = jamf.APIFamilyInformal
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if username == "" && password == "" && client.Authorizer != nil {
+		username, password, _ = client.Authorizer.BasicAuth()
+	}
 
 	c := &Client{
 		BaseClient: client,
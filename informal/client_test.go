@@ -2,11 +2,17 @@ package informal
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/jamfproapi"
 )
 
 func setup() (
@@ -29,6 +35,44 @@ func setup() (
 
 	return client, mux, server.URL, server.Close
 }
+
+func TestNewClient_WithAuthorizer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("token was requested, want the basic authentication")
+	})
+	mux.HandleFunc(distributionFileUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "dummyUsername" || password != "dummyPassword" {
+			t.Errorf("BasicAuth() returned (%q, %q, %v), want (\"dummyUsername\", \"dummyPassword\", true)", username, password, ok)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	authorizer := &jamfproapi.Authorizer{
+		BaseURL:  serverURL,
+		Username: "dummyUsername",
+		Password: "dummyPassword",
+	}
+
+	client, _ := NewClient(server.URL, "", "", jamf.WithAuthorizer(authorizer))
+	_, err := client.DistributionFileUpload.Upload(
+		context.Background(),
+		1,
+		"test.dmg",
+		DistributionFileUploadFileTypePackage,
+		DistributionFileUploadDestinationDefault,
+		strings.NewReader("test"),
+	)
+	if err != nil {
+		t.Fatalf("DistributionFileUpload.Upload(): %v", err)
+	}
+}
+
 func testMethod(t *testing.T, r *http.Request, want string) {
 	t.Helper()
 	if got := r.Method; got != want {
@@ -1,7 +1,6 @@
 package informal
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -28,13 +27,16 @@ const (
 
 const distributionFileUploadPath = "/dbfileupload"
 
+// Upload streams src to the distribution point without reading it into memory.
+// The upload can be retried only when src is an io.Seeker, e.g. *os.File, whose length is also sent as Content-Length.
 func (s *DistributionFileUploadService) Upload(ctx context.Context, packageID int, packageName string, fileType DistributionFileUploadFileType, destination DistributionFileUploadDestination, src io.Reader) (*jamf.Response, error) {
-	body := new(bytes.Buffer)
-
-	if _, err := io.Copy(body, src); err != nil {
-		return nil, fmt.Errorf("io.Copy(): %v", err)
-	}
+	return s.UploadWithProgress(ctx, packageID, packageName, fileType, destination, src, nil)
+}
 
+// UploadWithProgress is Upload reporting the progress of the upload to progress.
+// When ctx is canceled, the upload is aborted and the returned error satisfies errors.Is(err, context.Canceled),
+// while a failure at the server side is a *jamf.ErrorResponse.
+func (s *DistributionFileUploadService) UploadWithProgress(ctx context.Context, packageID int, packageName string, fileType DistributionFileUploadFileType, destination DistributionFileUploadDestination, src io.Reader, progress jamf.ProgressFunc) (*jamf.Response, error) {
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
 		ValidStatusCodes: []int{http.StatusOK},
 		Uri: jamf.Uri{
@@ -48,12 +50,25 @@ func (s *DistributionFileUploadService) Upload(ctx context.Context, packageID in
 			r.Header.Set("FILE_TYPE", string(fileType))
 			r.Header.Set("FILE_NAME", packageName)
 		},
-		Body: body,
+		Body:     src,
+		Progress: progress,
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, fmt.Errorf("client.Post(): %w", err)
 	}
 
 	return resp, nil
 }
+
+// UploadWithChecksum is UploadWithProgress computing the checksum of src while it is streamed,
+// e.g. to set it to the package with classic.Package.SetChecksum after the upload.
+func (s *DistributionFileUploadService) UploadWithChecksum(ctx context.Context, packageID int, packageName string, fileType DistributionFileUploadFileType, destination DistributionFileUploadDestination, src io.Reader, progress jamf.ProgressFunc) (*jamf.Checksum, *jamf.Response, error) {
+	checksumReader := jamf.NewChecksumReader(src)
+	resp, err := s.UploadWithProgress(ctx, packageID, packageName, fileType, destination, checksumReader, progress)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return checksumReader.Checksum(), resp, nil
+}
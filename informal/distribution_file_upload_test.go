@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
+	"time"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
 )
 
 // This data is base64 encoded 1x1 black PNG image
@@ -58,3 +63,107 @@ func TestDistributionFileUploadService_Upload(t *testing.T) {
 		t.Fatalf("DistributionFileUpload.Upload(): %v", err)
 	}
 }
+
+func TestDistributionFileUploadService_UploadWithProgress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	file := bytes.Repeat([]byte("a"), 100*1024)
+
+	mux.HandleFunc(distributionFileUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, file)
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var progresses []jamf.Progress
+	ctx := context.Background()
+	_, err := client.DistributionFileUpload.UploadWithProgress(
+		ctx,
+		1,
+		"test.pkg",
+		DistributionFileUploadFileTypePackage,
+		DistributionFileUploadDestinationDefault,
+		io.MultiReader(bytes.NewReader(file)),
+		func(p jamf.Progress) {
+			progresses = append(progresses, p)
+		},
+	)
+	if err != nil {
+		t.Fatalf("DistributionFileUpload.UploadWithProgress(): %v", err)
+	}
+
+	if len(progresses) == 0 {
+		t.Fatal("DistributionFileUpload.UploadWithProgress() reported no progress")
+	}
+	if last := progresses[len(progresses)-1]; last.BytesSent != int64(len(file)) {
+		t.Errorf("DistributionFileUpload.UploadWithProgress() reported %d bytes sent at last, want %d", last.BytesSent, len(file))
+	}
+}
+
+func TestDistributionFileUploadService_UploadWithProgress_Canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(distributionFileUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := client.DistributionFileUpload.UploadWithProgress(
+		ctx,
+		1,
+		"test.pkg",
+		DistributionFileUploadFileTypePackage,
+		DistributionFileUploadDestinationDefault,
+		io.MultiReader(bytes.NewReader(make([]byte, 1024))),
+		func(p jamf.Progress) {
+			cancel()
+		},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DistributionFileUpload.UploadWithProgress() returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDistributionFileUploadService_UploadWithChecksum(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(distributionFileUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte("abc"))
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	checksum, _, err := client.DistributionFileUpload.UploadWithChecksum(
+		ctx,
+		1,
+		"test.pkg",
+		DistributionFileUploadFileTypePackage,
+		DistributionFileUploadDestinationDefault,
+		bytes.NewReader([]byte("abc")),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("DistributionFileUpload.UploadWithChecksum(): %v", err)
+	}
+
+	want := &jamf.Checksum{
+		MD5:    "900150983cd24fb0d6963f7d28e17f72",
+		SHA512: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		Size:   3,
+	}
+	if *checksum != *want {
+		t.Errorf("DistributionFileUpload.UploadWithChecksum() returned %+v, want %+v", checksum, want)
+	}
+}
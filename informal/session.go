@@ -15,7 +15,7 @@ type SessionService service
 func (s *SessionService) Create(ctx context.Context, loginURL string) (*jamf.Response, error) {
 	u, err := url.Parse(loginURL)
 	if err != nil {
-		return nil, fmt.Errorf("url.Parse(): %v", err)
+		return nil, fmt.Errorf("url.Parse(): %w", err)
 	}
 
 	values := url.Values{
@@ -34,7 +34,7 @@ func (s *SessionService) Create(ctx context.Context, loginURL string) (*jamf.Res
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, fmt.Errorf("client.Post(): %w", err)
 	}
 
 	return resp, nil
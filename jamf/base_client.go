@@ -4,12 +4,13 @@
 package jamf
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/kenchan0130/go-jamf-pro/utils"
@@ -35,24 +36,59 @@ type HttpRequestInput interface {
 	GetContentType(defaultType string) string
 	GetValidStatusCodes() []int
 	GetValidStatusFunc() ValidStatusFunc
+	GetUri() Uri
 }
 
 type Uri struct {
 	Entity string
 	Params url.Values
+	// Route is the template of Entity given to the spans and the metrics, e.g. /v1/jcds/files/{fileName}.
+	// It must be set when Entity has a free-form value, otherwise the identifiers in Entity are replaced with placeholders.
+	Route string
 }
 
-// RetryableErrorHandler ensures that the response is returned after exhausting retries for a request
+// RetryableErrorHandler ensures that the response is returned after exhausting retries for a request.
+// The error is returned when there is no response, e.g. when the request is canceled or the connection fails.
 func RetryableErrorHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
+	if resp == nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
 type BaseClient struct {
 	// BaseURL is the base endpoint for Jamf Pro, e.g. https://yourdomain.jamfcloud.com
-	BaseURL            *url.URL
+	BaseURL *url.URL
+	// TokenSource supplies bearer tokens used to authorize requests.
+	TokenSource TokenSource
+	// Authorizer is the identity set by WithAuthorizer, whose BasicAuth is used by the informal API.
+	Authorizer Authorizer
+	// Deprecated: AuthorizationToken is used only when TokenSource is nil. Use TokenSource instead.
 	AuthorizationToken *string
 	DisableRetries     bool
 	DefaultContentType string
+	// RetryPolicy determines which requests are retried. DefaultRetryPolicy is used when it is nil.
+	RetryPolicy *RetryPolicy
+
+	// Logger logs the method, the URL, the status, the duration and the retries of requests. Nothing is logged when it is nil.
+	// Use WithLogger to route the logs of RetryableClient to it as well.
+	Logger Logger
+	// LogBodies makes Logger dump the headers and the bodies of requests at the debug level.
+	// Authorization headers and DefaultRedactedFields are always redacted.
+	LogBodies bool
+	// LogRedactedFields are the form fields, JSON keys and XML elements redacted from the dumped bodies in addition to DefaultRedactedFields.
+	LogRedactedFields []string
+
+	// Instrumentation traces and measures requests. Nothing is recorded when it is nil.
+	Instrumentation *Instrumentation
+	// APIFamily is the family of the APIs, which is recorded by Instrumentation.
+	APIFamily APIFamily
+
+	// RequestLimits limits the rate and the concurrency of requests. They are applied to every attempt including retries,
+	// and a request is in flight until its response body is closed.
+	RequestLimits *RequestLimits
+	// WriteRequestLimits limits the requests other than GET instead of RequestLimits, when it is not nil.
+	WriteRequestLimits *RequestLimits
 
 	// HttpClient is the underlying http.Client, which by default uses a retryable client
 	HttpClient      *http.Client
@@ -66,7 +102,6 @@ type Response struct {
 func NewBaseClient(baseURL *url.URL) *BaseClient {
 	r := retryablehttp.NewClient()
 	r.ErrorHandler = RetryableErrorHandler
-	r.RetryMax = DefaultRetryMax
 
 	c := &BaseClient{
 		BaseURL:         baseURL,
@@ -75,9 +110,29 @@ func NewBaseClient(baseURL *url.URL) *BaseClient {
 		DisableRetries:  false,
 	}
 
+	// The limits are applied under retryablehttp.Client, so that every retry waits for them as well.
+	r.HTTPClient.Transport = &limitedTransport{client: c, base: r.HTTPClient.Transport}
+
+	// The retry policy is configured once here, and the number of retries is limited by RetryPolicy.MaxRetries.
+	r.CheckRetry = c.checkRetry
+	r.Backoff = c.backoff
+	r.RetryMax = math.MaxInt32
+
 	return c
 }
 
+// Close revokes the token when the TokenSource is a RevocableTokenSource, so that it cannot be used anymore.
+// When the TokenSource is shared with other clients, they acquire a new token on their next request.
+func (c *BaseClient) Close() error {
+	if ts, ok := c.TokenSource.(RevocableTokenSource); ok {
+		if err := ts.Revoke(context.Background()); err != nil {
+			return fmt.Errorf("RevocableTokenSource.Revoke(): %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (c *BaseClient) buildUri(uri Uri) string {
 	newUrl := c.BaseURL.JoinPath(uri.Entity)
 	if uri.Params != nil {
@@ -87,66 +142,131 @@ func (c *BaseClient) buildUri(uri Uri) string {
 }
 
 func (c *BaseClient) performRequest(req *http.Request, input HttpRequestInput) (*http.Response, int, error) {
+	state := &requestState{
+		method:                 req.Method,
+		consistencyFailureFunc: input.GetConsistencyFailureFunc(),
+	}
+
+	req, finish := c.instrument(req, state, input.GetUri().Route)
+	resp, status, err := c.doRequest(req, input, state)
+	finish(status, err)
+
+	return resp, status, err
+}
+
+func (c *BaseClient) doRequest(req *http.Request, input HttpRequestInput, state *requestState) (*http.Response, int, error) {
 	var status int
 
 	if contentType := input.GetContentType(c.DefaultContentType); contentType != "" {
 		req.Header.Add("Content-Type", input.GetContentType(c.DefaultContentType))
 	}
-	if c.AuthorizationToken != nil {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", *c.AuthorizationToken))
+	token, err := c.authorize(req)
+	if err != nil {
+		return nil, status, err
 	}
 
 	f := input.GetRequestMiddlewareFunc()
 	if f != nil {
 		f(req)
 	}
+	// The middleware may replace the Authorization header, e.g. for basic authentication.
+	refreshable := c.TokenSource != nil && token != "" && req.Header.Get("Authorization") == bearerAuthorization(token)
 
-	c.RetryableClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		if resp != nil && !c.DisableRetries {
-			if resp.StatusCode == http.StatusFailedDependency {
-				return true, nil
-			}
-
-			f := input.GetConsistencyFailureFunc()
-			if f != nil && f(resp) {
-				return true, nil
-			}
-		}
+	req = req.WithContext(withRequestState(req.Context(), state))
 
-		//nolint:golint,wrapcheck // retryablehttp.DefaultRetryPolicy returns a non-wrapped error
-		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if b, ok := req.Body.(*rewindableBody); ok {
+		defer b.Close()
 	}
 
+	start := time.Now()
 	resp, err := c.HttpClient.Do(req)
 	if err != nil {
-		return nil, status, fmt.Errorf("http.Client#Do(): %v", err)
+		err = fmt.Errorf("http.Client#Do(): %w", err)
+		c.logRequest(req, nil, state, start, err)
+		return nil, status, err
 	}
 
 	if resp == nil {
 		return resp, status, fmt.Errorf("nil response received")
 	}
 
+	// The token may have expired or been revoked at the server side, so retry once with a new token.
+	if resp.StatusCode == http.StatusUnauthorized && refreshable {
+		c.TokenSource.Invalidate(token)
+		utils.HandleCloseFunc(resp.Body, c.RetryableClient.Logger)
+
+		resp, err = c.retryWithNewToken(req)
+		if err != nil {
+			c.logRequest(req, nil, state, start, err)
+			return nil, status, err
+		}
+	}
+	c.logBodies(req, resp)
+
 	status = resp.StatusCode
 	if !containsStatusCode(input.GetValidStatusCodes(), status) {
 		f := input.GetValidStatusFunc()
 		if f != nil && f(resp) {
+			c.logRequest(req, resp, state, start, nil)
 			return resp, status, nil
 		}
 
 		defer utils.HandleCloseFunc(resp.Body, c.RetryableClient.Logger)
 
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, status, fmt.Errorf("unexpected status %d, could not read response body", status)
+		errResp := newErrorResponse(req, resp)
+		c.logRequest(req, resp, state, start, errResp)
+		return nil, status, errResp
+	}
+
+	c.logRequest(req, resp, state, start, nil)
+	return resp, status, nil
+}
+
+func (c *BaseClient) authorize(req *http.Request) (string, error) {
+	if c.TokenSource == nil {
+		if c.AuthorizationToken != nil {
+			req.Header.Set("Authorization", bearerAuthorization(*c.AuthorizationToken))
 		}
-		if len(respBody) == 0 {
-			return nil, status, fmt.Errorf("unexpected status %d received with no body", status)
+		return "", nil
+	}
+
+	token, err := c.TokenSource.Token(req.Context())
+	if err != nil {
+		return "", fmt.Errorf("TokenSource.Token(): %w", err)
+	}
+	req.Header.Set("Authorization", bearerAuthorization(token))
+
+	return token, nil
+}
+
+func (c *BaseClient) retryWithNewToken(req *http.Request) (*http.Response, error) {
+	newReq := req.Clone(req.Context())
+	// A streamed body is rewound by retryablehttp.Client, and other bodies are read again by GetBody.
+	if _, ok := req.Body.(*rewindableBody); !ok && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("http.Request#GetBody(): %w", err)
 		}
-		errText := fmt.Sprintf("response: %s", respBody)
-		return nil, status, fmt.Errorf("unexpected status %d with %s", status, errText)
+		newReq.Body = body
 	}
 
-	return resp, status, nil
+	if _, err := c.authorize(newReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HttpClient.Do(newReq)
+	if err != nil {
+		return nil, fmt.Errorf("http.Client#Do(): %w", err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("nil response received")
+	}
+
+	return resp, nil
+}
+
+func bearerAuthorization(token string) string {
+	return fmt.Sprintf("Bearer %s", token)
 }
 
 func containsStatusCode(expected []int, actual int) bool {
@@ -195,6 +315,11 @@ func (i DeleteHttpRequestInput) GetValidStatusFunc() ValidStatusFunc {
 	return i.ValidStatusFunc
 }
 
+// GetUri returns the Uri of a DELETE request.
+func (i DeleteHttpRequestInput) GetUri() Uri {
+	return i.Uri
+}
+
 // Delete performs a DELETE request.
 func (c *BaseClient) Delete(ctx context.Context, input DeleteHttpRequestInput) (*Response, int, error) {
 	var status int
@@ -203,7 +328,7 @@ func (c *BaseClient) Delete(ctx context.Context, input DeleteHttpRequestInput) (
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, http.NoBody)
 	if err != nil {
-		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %w", err)
 	}
 
 	resp, status, err := c.performRequest(req, input)
@@ -251,6 +376,11 @@ func (i GetHttpRequestInput) GetValidStatusFunc() ValidStatusFunc {
 	return i.ValidStatusFunc
 }
 
+// GetUri returns the Uri of a GET request.
+func (i GetHttpRequestInput) GetUri() Uri {
+	return i.Uri
+}
+
 // Get performs a GET request.
 func (c *BaseClient) Get(ctx context.Context, input GetHttpRequestInput) (*Response, int, error) {
 	var status int
@@ -260,7 +390,7 @@ func (c *BaseClient) Get(ctx context.Context, input GetHttpRequestInput) (*Respo
 	// Build a new request
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
 	if err != nil {
-		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %w", err)
 	}
 
 	// Perform the request
@@ -274,7 +404,16 @@ func (c *BaseClient) Get(ctx context.Context, input GetHttpRequestInput) (*Respo
 
 // PatchHttpRequestInput configures a PATCH request.
 type PatchHttpRequestInput struct {
-	Body                   *bytes.Buffer
+	// Body is streamed unless it is a *bytes.Buffer, *bytes.Reader or *strings.Reader. It is not closed, since the caller owns it,
+	// while the readers returned by GetBody are closed.
+	Body io.Reader
+	// ContentLength is the length of Body. It is detected from Body when it is 0, e.g. for *os.File.
+	ContentLength int64
+	// GetBody returns a new reader of a streamed Body, so that the request can be retried.
+	// Without it, only a Body which is an io.Seeker can be retried.
+	GetBody GetBodyFunc
+	// Progress receives the progress of sending Body.
+	Progress               ProgressFunc
 	ConsistencyFailureFunc ConsistencyFailureFunc
 	ContentType            string
 	RequestMiddlewareFunc  RequestMiddlewareFunc
@@ -310,22 +449,25 @@ func (i PatchHttpRequestInput) GetValidStatusFunc() ValidStatusFunc {
 	return i.ValidStatusFunc
 }
 
+// GetUri returns the Uri of a PATCH request.
+func (i PatchHttpRequestInput) GetUri() Uri {
+	return i.Uri
+}
+
 // Patch performs a PATCH request.
 func (c *BaseClient) Patch(ctx context.Context, input PatchHttpRequestInput) (*Response, int, error) {
 	var status int
 
 	u := c.buildUri(input.Uri)
 
-	var inputBody io.Reader
-	if input.Body == nil {
-		inputBody = http.NoBody
-	} else {
-		inputBody = input.Body
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, inputBody)
+	req, err := newRequestWithBody(ctx, http.MethodPatch, u, requestBody{
+		body:          input.Body,
+		contentLength: input.ContentLength,
+		getBody:       input.GetBody,
+		progress:      input.Progress,
+	})
 	if err != nil {
-		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+		return nil, status, err
 	}
 
 	resp, status, err := c.performRequest(req, input)
@@ -338,7 +480,16 @@ func (c *BaseClient) Patch(ctx context.Context, input PatchHttpRequestInput) (*R
 
 // PostHttpRequestInput configures a POST request.
 type PostHttpRequestInput struct {
-	Body                   *bytes.Buffer
+	// Body is streamed unless it is a *bytes.Buffer, *bytes.Reader or *strings.Reader. It is not closed, since the caller owns it,
+	// while the readers returned by GetBody are closed.
+	Body io.Reader
+	// ContentLength is the length of Body. It is detected from Body when it is 0, e.g. for *os.File.
+	ContentLength int64
+	// GetBody returns a new reader of a streamed Body, so that the request can be retried.
+	// Without it, only a Body which is an io.Seeker can be retried.
+	GetBody GetBodyFunc
+	// Progress receives the progress of sending Body.
+	Progress               ProgressFunc
 	ContentType            string
 	ConsistencyFailureFunc ConsistencyFailureFunc
 	RequestMiddlewareFunc  RequestMiddlewareFunc
@@ -374,22 +525,25 @@ func (i PostHttpRequestInput) GetValidStatusFunc() ValidStatusFunc {
 	return i.ValidStatusFunc
 }
 
+// GetUri returns the Uri of a POST request.
+func (i PostHttpRequestInput) GetUri() Uri {
+	return i.Uri
+}
+
 // Post performs a POST request.
 func (c *BaseClient) Post(ctx context.Context, input PostHttpRequestInput) (*Response, int, error) {
 	var status int
 
 	u := c.buildUri(input.Uri)
 
-	var inputBody io.Reader
-	if input.Body == nil {
-		inputBody = http.NoBody
-	} else {
-		inputBody = input.Body
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, inputBody)
+	req, err := newRequestWithBody(ctx, http.MethodPost, u, requestBody{
+		body:          input.Body,
+		contentLength: input.ContentLength,
+		getBody:       input.GetBody,
+		progress:      input.Progress,
+	})
 	if err != nil {
-		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+		return nil, status, err
 	}
 
 	resp, status, err := c.performRequest(req, input)
@@ -402,7 +556,16 @@ func (c *BaseClient) Post(ctx context.Context, input PostHttpRequestInput) (*Res
 
 // PutHttpRequestInput configures a PUT request.
 type PutHttpRequestInput struct {
-	Body                   *bytes.Buffer
+	// Body is streamed unless it is a *bytes.Buffer, *bytes.Reader or *strings.Reader. It is not closed, since the caller owns it,
+	// while the readers returned by GetBody are closed.
+	Body io.Reader
+	// ContentLength is the length of Body. It is detected from Body when it is 0, e.g. for *os.File.
+	ContentLength int64
+	// GetBody returns a new reader of a streamed Body, so that the request can be retried.
+	// Without it, only a Body which is an io.Seeker can be retried.
+	GetBody GetBodyFunc
+	// Progress receives the progress of sending Body.
+	Progress               ProgressFunc
 	ConsistencyFailureFunc ConsistencyFailureFunc
 	ContentType            string
 	RequestMiddlewareFunc  RequestMiddlewareFunc
@@ -438,22 +601,25 @@ func (i PutHttpRequestInput) GetValidStatusFunc() ValidStatusFunc {
 	return i.ValidStatusFunc
 }
 
+// GetUri returns the Uri of a PUT request.
+func (i PutHttpRequestInput) GetUri() Uri {
+	return i.Uri
+}
+
 // Put performs a PUT request.
 func (c *BaseClient) Put(ctx context.Context, input PutHttpRequestInput) (*Response, int, error) {
 	var status int
 
 	u := c.buildUri(input.Uri)
 
-	var inputBody io.Reader
-	if input.Body == nil {
-		inputBody = http.NoBody
-	} else {
-		inputBody = input.Body
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, inputBody)
+	req, err := newRequestWithBody(ctx, http.MethodPut, u, requestBody{
+		body:          input.Body,
+		contentLength: input.ContentLength,
+		getBody:       input.GetBody,
+		progress:      input.Progress,
+	})
 	if err != nil {
-		return nil, status, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+		return nil, status, err
 	}
 
 	resp, status, err := c.performRequest(req, input)
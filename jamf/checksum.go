@@ -0,0 +1,165 @@
+package jamf
+
+import (
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+// The hash types of a package, which Jamf Pro uses to verify the package on devices.
+const (
+	HashTypeMD5    = "MD5"
+	HashTypeSHA512 = "SHA_512"
+)
+
+// ErrChecksumNotFound is returned when a package has no checksum to verify.
+var ErrChecksumNotFound = errors.New("checksum not found")
+
+// ChecksumMismatchError is returned when a checksum does not match the expected one.
+type ChecksumMismatchError struct {
+	HashType string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("%s checksum mismatch: expected %s, got %s", e.HashType, e.Expected, e.Actual)
+}
+
+// Checksum is the hex-encoded checksums of a file.
+type Checksum struct {
+	MD5    string
+	SHA512 string
+	Size   int64
+}
+
+// Verify compares the checksum with a checksum of the hash type, e.g. the HashType and HashValue of a package.
+func (c *Checksum) Verify(hashType string, hashValue string) error {
+	if hashValue == "" {
+		return ErrChecksumNotFound
+	}
+
+	var actual string
+	switch strings.ToUpper(hashType) {
+	case HashTypeMD5:
+		actual = c.MD5
+	case HashTypeSHA512, "SHA512", "SHA-512":
+		actual = c.SHA512
+	default:
+		return fmt.Errorf("unsupported hash type: %q", hashType)
+	}
+
+	if !strings.EqualFold(hashValue, actual) {
+		return &ChecksumMismatchError{HashType: hashType, Expected: hashValue, Actual: actual}
+	}
+
+	return nil
+}
+
+// ComputeChecksum reads r to the end once, and computes its MD5 and SHA-512 together.
+func ComputeChecksum(r io.Reader) (*Checksum, error) {
+	cr := NewChecksumReader(r)
+	if _, err := io.Copy(io.Discard, cr); err != nil {
+		return nil, fmt.Errorf("io.Copy(): %w", err)
+	}
+
+	return cr.Checksum(), nil
+}
+
+// ComputeFileChecksum computes the checksum of a local file, e.g. a package before it is uploaded.
+func ComputeFileChecksum(name string) (*Checksum, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open(): %w", err)
+	}
+	defer utils.HandleCloseFunc(f, nil)
+
+	return ComputeChecksum(f)
+}
+
+// ChecksumReader computes the checksum of a reader while it is read, e.g. while a package is uploaded.
+// When the reader is an io.Seeker, it can be rewound to the start for a retry, which restarts the checksum.
+type ChecksumReader struct {
+	r      io.Reader
+	md5    hash.Hash
+	sha512 hash.Hash
+	hashes io.Writer
+	size   int64
+
+	seeker io.Seeker
+	start  int64
+}
+
+// NewChecksumReader returns a ChecksumReader of r.
+// When it is rewound with Seek, or Reset is called, the checksum is reset and computed again from the start.
+func NewChecksumReader(r io.Reader) *ChecksumReader {
+	c := &ChecksumReader{r: r, md5: md5.New(), sha512: sha512.New()}
+	c.hashes = io.MultiWriter(c.md5, c.sha512)
+	if s, ok := r.(io.Seeker); ok {
+		if start, err := s.Seek(0, io.SeekCurrent); err == nil {
+			c.seeker = s
+			c.start = start
+		}
+	}
+
+	return c
+}
+
+func (c *ChecksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	_, _ = c.hashes.Write(p[:n])
+	c.size += int64(n)
+
+	return n, err
+}
+
+// Len returns the length of the rest of the underlying reader, or 0 when it is unknown,
+// so that the length of an upload is still sent as Content-Length.
+func (c *ChecksumReader) Len() int {
+	return int(readerLength(c.r))
+}
+
+// Seek supports only rewinding to the start and getting the current offset, which are enough for a retry.
+func (c *ChecksumReader) Seek(offset int64, whence int) (int64, error) {
+	if c.seeker == nil {
+		return 0, ErrBodyNotRewindable
+	}
+
+	switch {
+	case whence == io.SeekCurrent && offset == 0:
+		return c.start + c.size, nil
+	case whence == io.SeekStart && offset == c.start:
+		pos, err := c.seeker.Seek(offset, whence)
+		if err != nil {
+			return pos, err
+		}
+		c.Reset()
+		return pos, nil
+	}
+
+	return 0, errors.New("ChecksumReader.Seek(): can only rewind to the start")
+}
+
+// Reset restarts the checksum, e.g. when the underlying reader is rewound by the caller.
+func (c *ChecksumReader) Reset() {
+	c.md5.Reset()
+	c.sha512.Reset()
+	c.size = 0
+}
+
+// Checksum returns the checksum of the data read so far.
+func (c *ChecksumReader) Checksum() *Checksum {
+	return &Checksum{
+		MD5:    hex.EncodeToString(c.md5.Sum(nil)),
+		SHA512: hex.EncodeToString(c.sha512.Sum(nil)),
+		Size:   c.size,
+	}
+}
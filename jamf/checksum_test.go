@@ -0,0 +1,78 @@
+package jamf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var abcChecksum = Checksum{
+	MD5:    "900150983cd24fb0d6963f7d28e17f72",
+	SHA512: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+	Size:   3,
+}
+
+func TestComputeChecksum(t *testing.T) {
+	checksum, err := ComputeChecksum(strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("ComputeChecksum(): %v", err)
+	}
+
+	if *checksum != abcChecksum {
+		t.Errorf("ComputeChecksum() returned %+v, want %+v", checksum, abcChecksum)
+	}
+}
+
+func TestChecksum_Verify(t *testing.T) {
+	cases := []struct {
+		name      string
+		hashType  string
+		hashValue string
+		wantErr   error
+	}{
+		{name: "MD5", hashType: HashTypeMD5, hashValue: abcChecksum.MD5},
+		{name: "SHA-512 in upper case", hashType: HashTypeSHA512, hashValue: strings.ToUpper(abcChecksum.SHA512)},
+		{name: "empty", hashType: HashTypeSHA512, hashValue: "", wantErr: ErrChecksumNotFound},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := abcChecksum.Verify(c.hashType, c.hashValue); !errors.Is(err, c.wantErr) {
+				t.Errorf("Checksum.Verify() returned %v, want %v", err, c.wantErr)
+			}
+		})
+	}
+
+	var mismatchErr *ChecksumMismatchError
+	if err := abcChecksum.Verify(HashTypeMD5, "d41d8cd98f00b204e9800998ecf8427e"); !errors.As(err, &mismatchErr) {
+		t.Errorf("Checksum.Verify() returned %v, want *ChecksumMismatchError", err)
+	}
+}
+
+func TestChecksumReader_Retry(t *testing.T) {
+	recorder := &uploadRecorder{}
+	client, teardown := recorder.newClient(t, 1)
+	defer teardown()
+
+	checksumReader := NewChecksumReader(bytes.NewReader([]byte("abc")))
+	resp, _, err := client.Post(context.Background(), PostHttpRequestInput{
+		Body:             checksumReader,
+		ValidStatusCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Post(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(recorder.bodies) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(recorder.bodies))
+	}
+	if recorder.contentLengths[1] != 3 {
+		t.Errorf("request has Content-Length %d, want 3", recorder.contentLengths[1])
+	}
+	if checksum := checksumReader.Checksum(); *checksum != abcChecksum {
+		t.Errorf("ChecksumReader.Checksum() returned %+v, want %+v", checksum, abcChecksum)
+	}
+}
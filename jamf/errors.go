@@ -0,0 +1,144 @@
+package jamf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// ErrorResponse is returned when Jamf Pro responds with an unexpected status.
+type ErrorResponse struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Body is the raw response body.
+	Body []byte
+	// Errors are the details returned by the Jamf Pro API.
+	Errors []ErrorDetail
+	// Message is the text of the error page returned by the Classic API.
+	Message string
+}
+
+// ErrorDetail is an element of the errors array returned by the Jamf Pro API.
+type ErrorDetail struct {
+	Code        string `json:"code"`
+	Field       string `json:"field"`
+	Description string `json:"description"`
+	ID          string `json:"id"`
+}
+
+var (
+	htmlParagraphRegexp = regexp.MustCompile(`(?is)<p[^>]*>(.*?)</p>`)
+	htmlTagRegexp       = regexp.MustCompile(`(?s)<[^>]*>`)
+	whitespaceRegexp    = regexp.MustCompile(`\s+`)
+)
+
+func newErrorResponse(req *http.Request, resp *http.Response) *ErrorResponse {
+	errResp := &ErrorResponse{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return errResp
+	}
+	errResp.Body = body
+
+	var data struct {
+		Errors []ErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &data); err == nil {
+		errResp.Errors = data.Errors
+		return errResp
+	}
+
+	errResp.Message = extractErrorPageText(string(body))
+
+	return errResp
+}
+
+// extractErrorPageText extracts the text from an HTML or XML error page of the Classic API.
+func extractErrorPageText(page string) string {
+	var texts []string
+	for _, m := range htmlParagraphRegexp.FindAllStringSubmatch(page, -1) {
+		text := normalizeErrorPageText(m[1])
+		// The error page of the Classic API contains boilerplate paragraphs with links.
+		if text == "" || strings.HasPrefix(text, "You can get technical details") {
+			continue
+		}
+		texts = append(texts, text)
+	}
+	if len(texts) > 0 {
+		return strings.Join(texts, ": ")
+	}
+
+	return normalizeErrorPageText(page)
+}
+
+func normalizeErrorPageText(s string) string {
+	s = htmlTagRegexp.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	return strings.TrimSpace(whitespaceRegexp.ReplaceAllString(s, " "))
+}
+
+func (e *ErrorResponse) Error() string {
+	var details string
+	switch {
+	case len(e.Errors) > 0:
+		messages := make([]string, 0, len(e.Errors))
+		for _, d := range e.Errors {
+			message := fmt.Sprintf("%s: %s", d.Code, d.Description)
+			if d.Field != "" {
+				message = fmt.Sprintf("%s (field: %s)", message, d.Field)
+			}
+			messages = append(messages, message)
+		}
+		details = strings.Join(messages, ", ")
+	case e.Message != "":
+		details = e.Message
+	case len(e.Body) > 0:
+		details = string(e.Body)
+	default:
+		details = "no body"
+	}
+
+	return fmt.Sprintf("%s %s: unexpected status %d with response: %s", e.Method, e.URL, e.StatusCode, details)
+}
+
+// HasStatusCode reports whether err is an *ErrorResponse with the status code.
+func HasStatusCode(err error, statusCode int) bool {
+	var errResp *ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode == statusCode
+}
+
+// IsBadRequest reports whether err is an *ErrorResponse with 400 Bad Request.
+func IsBadRequest(err error) bool {
+	return HasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is an *ErrorResponse with 401 Unauthorized.
+func IsUnauthorized(err error) bool {
+	return HasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an *ErrorResponse with 403 Forbidden.
+func IsForbidden(err error) bool {
+	return HasStatusCode(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is an *ErrorResponse with 404 Not Found.
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an *ErrorResponse with 409 Conflict.
+func IsConflict(err error) bool {
+	return HasStatusCode(err, http.StatusConflict)
+}
@@ -0,0 +1,90 @@
+package jamf
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	cases := []struct {
+		name        string
+		statusCode  int
+		body        string
+		wantErrors  []ErrorDetail
+		wantMessage string
+	}{
+		{
+			name:       "Jamf Pro API",
+			statusCode: http.StatusNotFound,
+			body:       `{"httpStatus":404,"errors":[{"code":"INVALID_ID","field":"id","description":"Category with id 1 does not exist","id":"1"}]}`,
+			wantErrors: []ErrorDetail{
+				{Code: "INVALID_ID", Field: "id", Description: "Category with id 1 does not exist", ID: "1"},
+			},
+		},
+		{
+			name:       "Classic API",
+			statusCode: http.StatusConflict,
+			body: `<html>
+<head>
+   <title>Status page</title>
+</head>
+<body style="font-family:sans-serif;">
+<p style="font-size:1.2em;font-face:sans-serif;font-weight:bold;">Conflict</p>
+<p style="font-size:1.2em;font-face:sans-serif;">Error: Duplicate name</p>
+<p>You can get technical details <a href="http://www.w3.org/Protocols/rfc2616/rfc2616-sec10.html#sec10.4.10">here</a>.<br>
+Please continue your visit at our <a href="/">home page</a>.
+</p>
+</body>
+</html>`,
+			wantMessage: "Conflict: Error: Duplicate name",
+		},
+		{
+			name:        "XML",
+			statusCode:  http.StatusBadRequest,
+			body:        `<?xml version="1.0" encoding="UTF-8"?><error>Problem with &lt;name&gt;</error>`,
+			wantMessage: "Problem with <name>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://example.jamfcloud.com/api/v1/categories/1", http.NoBody)
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(tc.statusCode)
+			_, _ = fmt.Fprint(rec, tc.body)
+
+			errResp := newErrorResponse(req, rec.Result())
+
+			if errResp.StatusCode != tc.statusCode {
+				t.Errorf("ErrorResponse.StatusCode is %d, want %d", errResp.StatusCode, tc.statusCode)
+			}
+			if errResp.Method != http.MethodGet {
+				t.Errorf("ErrorResponse.Method is %q, want %q", errResp.Method, http.MethodGet)
+			}
+			if string(errResp.Body) != tc.body {
+				t.Errorf("ErrorResponse.Body is %q, want %q", errResp.Body, tc.body)
+			}
+			if !cmp.Equal(errResp.Errors, tc.wantErrors) {
+				t.Errorf("ErrorResponse.Errors is %v, want %v", errResp.Errors, tc.wantErrors)
+			}
+			if errResp.Message != tc.wantMessage {
+				t.Errorf("ErrorResponse.Message is %q, want %q", errResp.Message, tc.wantMessage)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	err := fmt.Errorf("client.Get(): %w", &ErrorResponse{StatusCode: http.StatusNotFound})
+
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound() returned false, want true")
+	}
+	if IsConflict(err) {
+		t.Errorf("IsConflict() returned true, want false")
+	}
+}
@@ -0,0 +1,162 @@
+package jamf
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// APIFamily is the family of the Jamf Pro APIs which a BaseClient calls.
+type APIFamily string
+
+const (
+	APIFamilyClassic  APIFamily = "classic"
+	APIFamilyPro      APIFamily = "pro"
+	APIFamilyInformal APIFamily = "informal"
+)
+
+// The keys of the attributes given to Tracer and Meter.
+const (
+	AttributeKeyAPIFamily  = "jamf.api_family"
+	AttributeKeyRetries    = "jamf.retries"
+	AttributeKeyMethod     = "http.request.method"
+	AttributeKeyRoute      = "http.route"
+	AttributeKeyStatusCode = "http.response.status_code"
+)
+
+// Attribute is a key-value pair describing a request, e.g. an OpenTelemetry attribute.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts a span for each request.
+// It can be implemented with an OpenTelemetry trace.Tracer by converting the attributes.
+type Tracer interface {
+	Start(ctx context.Context, spanName string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Span is a span started by Tracer.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records the metrics of each request.
+// It can be implemented with the OpenTelemetry instruments, e.g. a histogram and a counter.
+type Meter interface {
+	// RecordDuration records the latency of a request, including its retries.
+	RecordDuration(ctx context.Context, duration time.Duration, attributes ...Attribute)
+	// AddError counts a request which failed.
+	AddError(ctx context.Context, attributes ...Attribute)
+}
+
+// Propagator injects the trace context of ctx into the headers of a request.
+// An OpenTelemetry propagation.TextMapPropagator can be used with propagation.HeaderCarrier.
+type Propagator interface {
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Instrumentation traces and measures the requests of a BaseClient. Any of them may be nil.
+type Instrumentation struct {
+	Tracer     Tracer
+	Meter      Meter
+	Propagator Propagator
+}
+
+// instrument starts a span for the request, and returns the request with the context of the span and a function to finish it.
+// The route is derived from the path of the request when it is empty. It is a no-op when Instrumentation is not configured.
+func (c *BaseClient) instrument(req *http.Request, state *requestState, route string) (*http.Request, func(status int, err error)) {
+	i := c.Instrumentation
+	if i == nil || (i.Tracer == nil && i.Meter == nil && i.Propagator == nil) {
+		return req, func(int, error) {}
+	}
+
+	if route == "" {
+		route = entityTemplate(strings.TrimPrefix(req.URL.Path, c.BaseURL.Path))
+	}
+	attributes := []Attribute{
+		{Key: AttributeKeyAPIFamily, Value: string(c.APIFamily)},
+		{Key: AttributeKeyMethod, Value: req.Method},
+		{Key: AttributeKeyRoute, Value: route},
+	}
+
+	ctx := req.Context()
+	var span Span
+	if i.Tracer != nil {
+		ctx, span = i.Tracer.Start(ctx, req.Method+" "+route, attributes...)
+		req = req.WithContext(ctx)
+	}
+	if i.Propagator != nil {
+		i.Propagator.Inject(ctx, req.Header)
+	}
+
+	start := time.Now()
+	return req, func(status int, err error) {
+		if status != 0 {
+			attributes = append(attributes, Attribute{Key: AttributeKeyStatusCode, Value: status})
+		}
+
+		if span != nil {
+			span.SetAttributes(append(attributes, Attribute{Key: AttributeKeyRetries, Value: state.retries})...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}
+
+		if i.Meter != nil {
+			i.Meter.RecordDuration(ctx, time.Since(start), attributes...)
+			if err != nil {
+				i.Meter.AddError(ctx, attributes...)
+			}
+		}
+	}
+}
+
+var (
+	// classicIdentifierKeys are the path segments of the Classic API followed by an identifier, e.g. /computers/serialnumber/{serialnumber}.
+	classicIdentifierKeys = map[string]bool{
+		"id":           true,
+		"name":         true,
+		"udid":         true,
+		"serialnumber": true,
+		"macaddress":   true,
+		"username":     true,
+		"subset":       true,
+		"match":        true,
+		"bundleid":     true,
+		"version":      true,
+	}
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// entityTemplate replaces the identifiers in the path of an entity with placeholders, so that the spans and the metrics have a low cardinality.
+// e.g. /computers/id/1 becomes /computers/id/{id}, and /v1/scripts/1 becomes /v1/scripts/{id}.
+func entityTemplate(entity string) string {
+	segments := strings.Split(entity, "/")
+	for i, s := range segments {
+		switch {
+		case i > 0 && classicIdentifierKeys[segments[i-1]] && !strings.HasPrefix(s, "{"):
+			segments[i] = "{" + segments[i-1] + "}"
+		case isNumeric(s) || uuidPattern.MatchString(s):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,183 @@
+package jamf
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type traceIDKey struct{}
+
+type recordedSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+type inMemoryTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (t *inMemoryTracer) Start(ctx context.Context, spanName string, attributes ...Attribute) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := &recordedSpan{name: spanName, attributes: map[string]interface{}{}}
+	s.SetAttributes(attributes...)
+	t.spans = append(t.spans, s)
+
+	return context.WithValue(ctx, traceIDKey{}, spanName), s
+}
+
+func (s *recordedSpan) SetAttributes(attributes ...Attribute) {
+	for _, a := range attributes {
+		s.attributes[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) { s.err = err }
+
+func (s *recordedSpan) End() { s.ended = true }
+
+type inMemoryMeter struct {
+	mu        sync.Mutex
+	durations []time.Duration
+	errors    int
+}
+
+func (m *inMemoryMeter) RecordDuration(_ context.Context, duration time.Duration, _ ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.durations = append(m.durations, duration)
+}
+
+func (m *inMemoryMeter) AddError(_ context.Context, _ ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.errors++
+}
+
+type headerPropagator struct{}
+
+func (headerPropagator) Inject(ctx context.Context, header http.Header) {
+	if v, ok := ctx.Value(traceIDKey{}).(string); ok {
+		header.Set("X-Trace", v)
+	}
+}
+
+func TestBaseClient_Instrumentation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("X-Trace"), "GET /computers/id/{id}"; got != want {
+			t.Errorf("Header.Get(X-Trace) returned %q, want %q", got, want)
+		}
+		if r.URL.Path == "/JSSResource/computers/id/2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL + "/JSSResource")
+	client := NewBaseClient(u)
+	client.APIFamily = APIFamilyClassic
+	tracer := &inMemoryTracer{}
+	meter := &inMemoryMeter{}
+	WithInstrumentation(&Instrumentation{Tracer: tracer, Meter: meter, Propagator: headerPropagator{}})(client)
+
+	ctx := context.Background()
+	resp, _, err := client.Get(ctx, GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}, Uri: Uri{Entity: "/computers/id/1"}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	_, _, err = client.Get(ctx, GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}, Uri: Uri{Entity: "/computers/id/2"}})
+	if !IsNotFound(err) {
+		t.Fatalf("BaseClient.Get() returned %v, want status %d", err, http.StatusNotFound)
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("%d spans were recorded, want 2", len(tracer.spans))
+	}
+	expected := map[string]interface{}{
+		AttributeKeyAPIFamily:  "classic",
+		AttributeKeyMethod:     http.MethodGet,
+		AttributeKeyRoute:      "/computers/id/{id}",
+		AttributeKeyStatusCode: http.StatusOK,
+		AttributeKeyRetries:    0,
+	}
+	if !cmp.Equal(tracer.spans[0].attributes, expected) {
+		t.Errorf("span attributes: %s", cmp.Diff(expected, tracer.spans[0].attributes))
+	}
+	if !tracer.spans[0].ended || tracer.spans[0].err != nil {
+		t.Errorf("span %+v is not ended successfully", tracer.spans[0])
+	}
+	if !tracer.spans[1].ended || !IsNotFound(tracer.spans[1].err) {
+		t.Errorf("span %+v does not record the error", tracer.spans[1])
+	}
+
+	if len(meter.durations) != 2 || meter.errors != 1 {
+		t.Errorf("meter recorded %d durations and %d errors, want 2 and 1", len(meter.durations), meter.errors)
+	}
+}
+
+func TestBaseClient_Instrumentation_Route(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL + "/api")
+	client := NewBaseClient(u)
+	tracer := &inMemoryTracer{}
+	WithInstrumentation(&Instrumentation{Tracer: tracer})(client)
+
+	_, _, err := client.Delete(context.Background(), DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri:              Uri{Entity: "/v1/jcds/files/installer.pkg", Route: "/v1/jcds/files/{fileName}"},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Delete(): %v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("%d spans were recorded, want 1", len(tracer.spans))
+	}
+	if got, want := tracer.spans[0].name, "DELETE /v1/jcds/files/{fileName}"; got != want {
+		t.Errorf("span name is %q, want %q", got, want)
+	}
+	if got, want := tracer.spans[0].attributes[AttributeKeyRoute], "/v1/jcds/files/{fileName}"; got != want {
+		t.Errorf("span attribute %s is %v, want %q", AttributeKeyRoute, got, want)
+	}
+}
+
+func TestEntityTemplate(t *testing.T) {
+	tests := []struct {
+		entity   string
+		expected string
+	}{
+		{entity: "/computers/id/1", expected: "/computers/id/{id}"},
+		{entity: "/computers/name/My Mac/subset/General", expected: "/computers/name/{name}/subset/{subset}"},
+		{entity: "/mobiledevices/serialnumber/C02ABC", expected: "/mobiledevices/serialnumber/{serialnumber}"},
+		{entity: "/computers/match/C02*", expected: "/computers/match/{match}"},
+		{entity: "/mobiledeviceapplications/bundleid/com.apple.Pages/version/14.0", expected: "/mobiledeviceapplications/bundleid/{bundleid}/version/{version}"},
+		{entity: "/v1/scripts/12", expected: "/v1/scripts/{id}"},
+		{entity: "/v1/jcds/files/6b1dd3e1-6a3a-4c1b-9e6f-6b0d5a1b2c3d", expected: "/v1/jcds/files/{id}"},
+		{entity: "/v1/categories", expected: "/v1/categories"},
+	}
+
+	for _, tt := range tests {
+		if got := entityTemplate(tt.entity); got != tt.expected {
+			t.Errorf("entityTemplate(%q) returned %q, want %q", tt.entity, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package jamf
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	redactedValue = "REDACTED"
+	// maxLoggedBodySize is the maximum size of a body logged by BaseClient, the rest is truncated.
+	maxLoggedBodySize = 64 * 1024
+)
+
+var (
+	// DefaultRedactedFields are the form fields, JSON keys and XML elements always redacted from the logged bodies.
+	DefaultRedactedFields = []string{"password", "client_secret", "token", "access_token"}
+	// ScriptContentsFields are the fields of script contents, which can be redacted with WithBodyLogging.
+	ScriptContentsFields = []string{"scriptContents", "script_contents"}
+
+	redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+)
+
+// Logger is a leveled and structured logger, which *slog.Logger satisfies.
+// The args are alternating keys and values as in log/slog.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// retryableLogger routes the logs of retryablehttp.Client to a Logger.
+// Its debug logs are dropped, because BaseClient logs the requests and the retries itself.
+type retryableLogger struct {
+	logger Logger
+}
+
+func (l retryableLogger) Debug(string, ...interface{}) {}
+
+func (l retryableLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+func (l retryableLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValues...)
+}
+
+func (l retryableLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValues...)
+}
+
+// logRequest logs the result of a request, including its retries.
+func (c *BaseClient) logRequest(req *http.Request, resp *http.Response, state *requestState, start time.Time, err error) {
+	if c.Logger == nil {
+		return
+	}
+
+	args := []interface{}{
+		"method", req.Method,
+		"url", req.URL.Redacted(),
+	}
+	if resp != nil {
+		args = append(args, "status", resp.StatusCode)
+	}
+	args = append(args, "duration", time.Since(start), "retries", state.retries)
+
+	if err != nil {
+		c.Logger.Error("request failed", append(args, "error", err)...)
+		return
+	}
+	c.Logger.Debug("request completed", args...)
+}
+
+// logRetry logs a retry decided by checkRetry.
+func (c *BaseClient) logRetry(resp *http.Response, state *requestState, err error) {
+	if c.Logger == nil {
+		return
+	}
+
+	args := []interface{}{"method", state.method, "retry", state.retries}
+	if resp != nil {
+		if resp.Request != nil {
+			args = append(args, "url", resp.Request.URL.Redacted())
+		}
+		args = append(args, "status", resp.StatusCode)
+	}
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	c.Logger.Debug("retrying request", args...)
+}
+
+// logBodies logs the headers and the bodies of a request and its response at the debug level, when LogBodies is enabled.
+// At most maxLoggedBodySize bytes of the response body are read, and they are put back in front of the rest of it,
+// so that the whole body can still be read by the caller. Binary bodies are not dumped.
+func (c *BaseClient) logBodies(req *http.Request, resp *http.Response) {
+	if c.Logger == nil || !c.LogBodies {
+		return
+	}
+
+	fields := append(append([]string{}, DefaultRedactedFields...), c.LogRedactedFields...)
+
+	args := []interface{}{
+		"method", req.Method,
+		"url", req.URL.Redacted(),
+		"request_headers", redactHeader(req.Header),
+	}
+	// A streamed body is not dumped, because it cannot be read again without GetBody and may be large,
+	// and neither is a binary body.
+	if _, streamed := req.Body.(*rewindableBody); !streamed && req.GetBody != nil && !isBinaryContentType(req.Header.Get("Content-Type")) {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+			_ = body.Close()
+			args = append(args, "request_body", redactBody(b, req.Header.Get("Content-Type"), fields))
+		}
+	}
+
+	var b []byte
+	if !isBinaryContentType(resp.Header.Get("Content-Type")) {
+		var err error
+		b, err = io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize))
+		var rest io.Reader = resp.Body
+		if err != nil {
+			rest = errorReader{err}
+		}
+		resp.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(b), rest), Closer: resp.Body}
+	}
+	args = append(args,
+		"status", resp.StatusCode,
+		"response_headers", redactHeader(resp.Header),
+		"response_body", redactBody(b, resp.Header.Get("Content-Type"), fields),
+	)
+
+	c.Logger.Debug("request dump", args...)
+}
+
+// prefixedBody is a response body whose logged prefix is read again before the rest of it.
+// It keeps the original closer, so that closing it still releases the connection and the request limits.
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// isBinaryContentType reports whether a body of the content type is binary and should not be logged.
+func isBinaryContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "application/octet-stream",
+		mediaType == "application/zip",
+		mediaType == "application/pdf",
+		strings.HasPrefix(mediaType, "image/"),
+		strings.HasPrefix(mediaType, "audio/"),
+		strings.HasPrefix(mediaType, "video/"):
+		return true
+	}
+	return false
+}
+
+// errorReader returns the error which occurred when a body was read for logging.
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func redactHeader(header http.Header) http.Header {
+	h := header.Clone()
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redactedValue)
+		}
+	}
+	return h
+}
+
+// redactBody replaces the values of the fields in a form, JSON or XML body.
+func redactBody(body []byte, contentType string, fields []string) string {
+	if len(body) == 0 || len(fields) == 0 {
+		return string(body)
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			for _, f := range fields {
+				if _, ok := values[f]; ok {
+					values.Set(f, redactedValue)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	quoted := make([]string, 0, len(fields))
+	for _, f := range fields {
+		quoted = append(quoted, regexp.QuoteMeta(f))
+	}
+	names := strings.Join(quoted, "|")
+
+	jsonPattern := regexp.MustCompile(`"(` + names + `)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	s := jsonPattern.ReplaceAllString(string(body), `"${1}"${2}"`+redactedValue+`"`)
+
+	xmlPattern := regexp.MustCompile(`(?s)<(` + names + `)>.*?</(?:` + names + `)>`)
+	return xmlPattern.ReplaceAllString(s, `<${1}>`+redactedValue+`</${1}>`)
+}
@@ -0,0 +1,179 @@
+package jamf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *testLogger) log(level string, msg string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, fmt.Sprintf("%s %s %v", level, msg, args))
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("DEBUG", msg, args...) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("INFO", msg, args...) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("WARN", msg, args...) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("ERROR", msg, args...) }
+
+func (l *testLogger) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return strings.Join(l.entries, "\n")
+}
+
+func TestBaseClient_Logger(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"token":"secret-token","expires":"2023-01-01T00:00:00Z"}`)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	token := "secret-bearer"
+	client.AuthorizationToken = &token
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.WaitMin = time.Millisecond
+	logger := &testLogger{}
+	WithLogger(logger)(client)
+	WithBodyLogging(ScriptContentsFields...)(client)
+
+	body := bytes.NewBufferString(`{"name":"script","scriptContents":"echo secret-script"}`)
+	resp, _, err := client.Put(context.Background(), PutHttpRequestInput{
+		Body:             body,
+		ContentType:      "application/json",
+		Uri:              Uri{Entity: "/api/v1/scripts/1"},
+		ValidStatusCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Put(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	var b bytes.Buffer
+	if _, err := b.ReadFrom(resp.Body); err != nil {
+		t.Fatalf("ReadFrom(): %v", err)
+	}
+	if !strings.Contains(b.String(), "secret-token") {
+		t.Errorf("response body %q is not readable after it is logged", b.String())
+	}
+
+	logs := logger.String()
+	for _, secret := range []string{"secret-bearer", "secret-token", "secret-script"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, logs)
+		}
+	}
+	for _, expected := range []string{"DEBUG retrying request", "DEBUG request completed", "PUT", "/api/v1/scripts/1", "status 200", "retries 1", `"name":"script"`} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("logs do not contain %q:\n%s", expected, logs)
+		}
+	}
+}
+
+func TestBaseClient_Logger_Form(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	logger := &testLogger{}
+	WithLogger(logger)(client)
+	client.LogBodies = true
+
+	_, _, err := client.Post(context.Background(), PostHttpRequestInput{
+		Body:             bytes.NewBufferString(url.Values{"username": {"admin"}, "password": {"secret-password"}}.Encode()),
+		ContentType:      "application/x-www-form-urlencoded",
+		ValidStatusCodes: []int{http.StatusFound},
+		RequestMiddlewareFunc: func(r *http.Request) {
+			r.SetBasicAuth("admin", "secret-password")
+		},
+	})
+	if !IsUnauthorized(err) {
+		t.Fatalf("BaseClient.Post() returned %v, want status %d", err, http.StatusUnauthorized)
+	}
+
+	logs := logger.String()
+	if strings.Contains(logs, "secret-password") {
+		t.Errorf("logs contain the password:\n%s", logs)
+	}
+	for _, expected := range []string{"ERROR request failed", "username=admin", "status 401"} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("logs do not contain %q:\n%s", expected, logs)
+		}
+	}
+}
+
+func TestBaseClient_Logger_LargeAndBinaryBodies(t *testing.T) {
+	large := strings.Repeat("a", maxLoggedBodySize) + "truncated-tail"
+	binary := "binary-contents"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, large)
+	})
+	mux.HandleFunc("/binary", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, binary)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	logger := &testLogger{}
+	WithLogger(logger)(client)
+	client.LogBodies = true
+
+	for path, want := range map[string]string{"/large": large, "/binary": binary} {
+		resp, _, err := client.Get(context.Background(), GetHttpRequestInput{
+			Uri:              Uri{Entity: path},
+			ValidStatusCodes: []int{http.StatusOK},
+		})
+		if err != nil {
+			t.Fatalf("BaseClient.Get(%q): %v", path, err)
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("ReadFrom(): %v", err)
+		}
+		resp.Body.Close()
+		if b.String() != want {
+			t.Errorf("response body of %q has %d bytes, want %d bytes", path, b.Len(), len(want))
+		}
+	}
+
+	logs := logger.String()
+	for _, unexpected := range []string{"truncated-tail", binary} {
+		if strings.Contains(logs, unexpected) {
+			t.Errorf("logs contain %q", unexpected)
+		}
+	}
+	if !strings.Contains(logs, "/binary") {
+		t.Errorf("logs do not contain the binary request:\n%s", logs)
+	}
+}
@@ -0,0 +1,71 @@
+package jamf
+
+// ClientOption configures a BaseClient when a client of an API family is created.
+type ClientOption func(*BaseClient)
+
+// WithTokenSource sets the TokenSource used to authorize requests.
+// Sharing one TokenSource between the clients of every API family lets them use a single token.
+func WithTokenSource(tokenSource TokenSource) ClientOption {
+	return func(c *BaseClient) {
+		c.TokenSource = tokenSource
+	}
+}
+
+// WithAuthorizer authenticates the client as the identity of the authorizer.
+// Passing the same Authorizer to the clients of every API family lets them use a single token and a single set of credentials.
+func WithAuthorizer(authorizer Authorizer) ClientOption {
+	return func(c *BaseClient) {
+		c.Authorizer = authorizer
+		// The informal API accepts only the basic authentication, which its services set to each request.
+		if c.APIFamily != APIFamilyInformal {
+			c.TokenSource = authorizer.TokenSource()
+		}
+	}
+}
+
+// WithRequestLimits sets the RequestLimits applied to every request.
+func WithRequestLimits(limits *RequestLimits) ClientOption {
+	return func(c *BaseClient) {
+		c.RequestLimits = limits
+	}
+}
+
+// WithWriteRequestLimits sets the RequestLimits applied to the requests other than GET, e.g. to protect the Classic API from parallel writes.
+func WithWriteRequestLimits(limits *RequestLimits) ClientOption {
+	return func(c *BaseClient) {
+		c.WriteRequestLimits = limits
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy which determines which requests are retried.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *BaseClient) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithLogger sets the Logger which logs the requests, and routes the logs of RetryableClient to it.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *BaseClient) {
+		c.Logger = logger
+		if logger != nil {
+			c.RetryableClient.Logger = retryableLogger{logger: logger}
+		}
+	}
+}
+
+// WithBodyLogging makes the Logger dump the bodies of requests at the debug level.
+// The values of the redactedFields, e.g. ScriptContentsFields, are redacted in addition to DefaultRedactedFields.
+func WithBodyLogging(redactedFields ...string) ClientOption {
+	return func(c *BaseClient) {
+		c.LogBodies = true
+		c.LogRedactedFields = append(c.LogRedactedFields, redactedFields...)
+	}
+}
+
+// WithInstrumentation sets the Instrumentation which traces and measures the requests.
+func WithInstrumentation(instrumentation *Instrumentation) ClientOption {
+	return func(c *BaseClient) {
+		c.Instrumentation = instrumentation
+	}
+}
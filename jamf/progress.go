@@ -0,0 +1,20 @@
+package jamf
+
+import "time"
+
+// progressInterval is the minimum interval between the calls of a ProgressFunc.
+const progressInterval = 100 * time.Millisecond
+
+// Progress is the progress of sending a request body.
+type Progress struct {
+	// BytesSent is the number of bytes sent in the current attempt. It is reset when the request is retried.
+	BytesSent int64
+	// Total is the length of the body, or 0 when it is unknown.
+	Total int64
+	// BytesPerSecond is the average rate of the current attempt.
+	BytesPerSecond float64
+}
+
+// ProgressFunc receives the progress of sending a request body.
+// It is called at most once per 100ms, and always when the whole body has been read.
+type ProgressFunc func(Progress)
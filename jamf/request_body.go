@@ -0,0 +1,202 @@
+package jamf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrBodyNotRewindable is returned when a request has to be sent again, but its body cannot be read again.
+// Set GetBody of the request input, or use an io.Seeker as the body, so that the request can be retried.
+var ErrBodyNotRewindable = errors.New("request body cannot be rewound")
+
+// GetBodyFunc returns a new reader of a request body, so that the request can be sent again.
+type GetBodyFunc func() (io.ReadCloser, error)
+
+// requestBody is the body of a POST, PUT or PATCH request input.
+type requestBody struct {
+	body          io.Reader
+	contentLength int64
+	getBody       GetBodyFunc
+	progress      ProgressFunc
+}
+
+// newRequestWithBody builds a request whose body is streamed, unless it is already in memory and its progress is not reported.
+func newRequestWithBody(ctx context.Context, method string, u string, input requestBody) (*http.Request, error) {
+	body := input.body
+	if body == nil {
+		body = http.NoBody
+	}
+	if b, ok := body.(*bytes.Buffer); ok && b == nil {
+		body = http.NoBody
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext(): %w", err)
+	}
+	if body == http.NoBody {
+		return req, nil
+	}
+
+	b := &rewindableBody{ctx: ctx, current: body, getBody: input.getBody, progress: input.progress}
+	switch body.(type) {
+	case *bytes.Buffer, *bytes.Reader, *strings.Reader:
+		// http.NewRequestWithContext sets ContentLength and GetBody for the bodies in memory.
+		if input.progress == nil {
+			return req, nil
+		}
+		b.current = req.Body
+		b.getBody = req.GetBody
+		b.owned = true
+	default:
+		if input.contentLength > 0 {
+			req.ContentLength = input.contentLength
+		} else {
+			req.ContentLength = readerLength(body)
+		}
+		if s, ok := body.(io.Seeker); ok && input.getBody == nil {
+			offset, err := s.Seek(0, io.SeekCurrent)
+			if err == nil {
+				b.seeker = s
+				b.offset = offset
+			}
+		}
+	}
+	b.total = req.ContentLength
+	req.Body = b
+	req.GetBody = b.getBody
+
+	return req, nil
+}
+
+// readerLength returns the remaining length of a reader, or 0 when it is unknown.
+func readerLength(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case interface {
+		io.Seeker
+		Stat() (os.FileInfo, error)
+	}:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0
+		}
+		return info.Size() - offset
+	}
+
+	return 0
+}
+
+// rewindableBody is a request body which is streamed and can be rewound for a retry.
+// It implements io.Seeker, so that retryablehttp.Client rewinds it instead of reading it into memory.
+type rewindableBody struct {
+	ctx     context.Context
+	current io.Reader
+	getBody GetBodyFunc
+	seeker  io.Seeker
+	offset  int64
+	read    bool
+	// owned is whether the current reader was returned by getBody, and is closed by Close.
+	// The body given by the caller is never closed, since the caller opened it.
+	owned bool
+
+	progress   ProgressFunc
+	total      int64
+	sent       int64
+	start      time.Time
+	reportedAt time.Time
+	reported   bool
+}
+
+func (b *rewindableBody) Read(p []byte) (int, error) {
+	// Stop reading as soon as the context is done, so that a long upload is aborted promptly.
+	if err := b.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if !b.read {
+		b.read = true
+		b.start = time.Now()
+	}
+	n, err := b.current.Read(p)
+	b.sent += int64(n)
+	if b.progress != nil {
+		b.reportProgress(err == io.EOF || (b.total > 0 && b.sent >= b.total))
+	}
+
+	return n, err
+}
+
+// reportProgress calls the ProgressFunc at most once per progressInterval, and always at the end of the body.
+func (b *rewindableBody) reportProgress(done bool) {
+	if b.reported && done {
+		return
+	}
+	now := time.Now()
+	if !done && now.Sub(b.reportedAt) < progressInterval {
+		return
+	}
+	b.reportedAt = now
+	b.reported = done
+
+	var rate float64
+	if elapsed := now.Sub(b.start).Seconds(); elapsed > 0 {
+		rate = float64(b.sent) / elapsed
+	}
+	b.progress(Progress{BytesSent: b.sent, Total: b.total, BytesPerSecond: rate})
+}
+
+// Seek supports only rewinding to the start of the body.
+func (b *rewindableBody) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("rewindableBody.Seek(): only rewinding to the start is supported")
+	}
+	if !b.read {
+		return 0, nil
+	}
+
+	switch {
+	case b.getBody != nil:
+		_ = b.Close()
+		body, err := b.getBody()
+		if err != nil {
+			return 0, fmt.Errorf("GetBody(): %w", err)
+		}
+		b.current = body
+		b.owned = true
+	case b.seeker != nil:
+		if _, err := b.seeker.Seek(b.offset, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("io.Seeker.Seek(): %w", err)
+		}
+	default:
+		return 0, ErrBodyNotRewindable
+	}
+	b.read = false
+	b.sent = 0
+	b.reported = false
+
+	return 0, nil
+}
+
+// Close closes the current reader when it was returned by GetBody, as http.Client closes a request body.
+func (b *rewindableBody) Close() error {
+	if !b.owned {
+		return nil
+	}
+	if c, ok := b.current.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
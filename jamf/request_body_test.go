@@ -0,0 +1,141 @@
+package jamf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type uploadRecorder struct {
+	count          int32
+	bodies         []string
+	contentLengths []int64
+}
+
+func (u *uploadRecorder) newClient(t *testing.T, failures int32) (*BaseClient, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		u.bodies = append(u.bodies, string(b))
+		u.contentLengths = append(u.contentLengths, r.ContentLength)
+		if atomic.AddInt32(&u.count, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	parsed, _ := url.Parse(server.URL)
+	client := NewBaseClient(parsed)
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.WaitMin = time.Millisecond
+
+	return client, server.Close
+}
+
+func TestBaseClient_Post_StreamedBodyWithGetBody(t *testing.T) {
+	recorder := &uploadRecorder{}
+	client, teardown := recorder.newClient(t, 1)
+	defer teardown()
+
+	content := "streamed content"
+	newBody := func() io.Reader {
+		// io.MultiReader is neither in memory nor an io.Seeker.
+		return io.MultiReader(strings.NewReader(content[:8]), strings.NewReader(content[8:]))
+	}
+
+	resp, _, err := client.Post(context.Background(), PostHttpRequestInput{
+		Body:          newBody(),
+		ContentLength: int64(len(content)),
+		GetBody: func() (io.ReadCloser, error) {
+			return io.NopCloser(newBody()), nil
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Post(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if len(recorder.bodies) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(recorder.bodies))
+	}
+	for i, body := range recorder.bodies {
+		if body != content {
+			t.Errorf("request %d has body %q, want %q", i, body, content)
+		}
+		if recorder.contentLengths[i] != int64(len(content)) {
+			t.Errorf("request %d has Content-Length %d, want %d", i, recorder.contentLengths[i], len(content))
+		}
+	}
+}
+
+func TestBaseClient_Post_StreamedFile(t *testing.T) {
+	recorder := &uploadRecorder{}
+	client, teardown := recorder.newClient(t, 1)
+	defer teardown()
+
+	content := []byte("file content")
+	name := filepath.Join(t.TempDir(), "test.pkg")
+	if err := os.WriteFile(name, content, 0o600); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("os.Open(): %v", err)
+	}
+	defer f.Close()
+
+	resp, _, err := client.Put(context.Background(), PutHttpRequestInput{
+		Body:             f,
+		ValidStatusCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Put(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// The file is opened by the caller, so it must not be closed by the client.
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Errorf("file cannot be used after the request: %v", err)
+	}
+
+	if len(recorder.bodies) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(recorder.bodies))
+	}
+	for i, body := range recorder.bodies {
+		if body != string(content) {
+			t.Errorf("request %d has body %q, want %q", i, body, content)
+		}
+		if recorder.contentLengths[i] != int64(len(content)) {
+			t.Errorf("request %d has Content-Length %d, want %d", i, recorder.contentLengths[i], len(content))
+		}
+	}
+}
+
+func TestBaseClient_Post_StreamedBodyNotRewindable(t *testing.T) {
+	recorder := &uploadRecorder{}
+	client, teardown := recorder.newClient(t, 1)
+	defer teardown()
+
+	_, _, err := client.Post(context.Background(), PostHttpRequestInput{
+		Body:             io.MultiReader(bytes.NewBufferString("content")),
+		ValidStatusCodes: []int{http.StatusOK},
+	})
+	if !errors.Is(err, ErrBodyNotRewindable) {
+		t.Errorf("BaseClient.Post() returned %v, want %v", err, ErrBodyNotRewindable)
+	}
+	if got := atomic.LoadInt32(&recorder.count); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
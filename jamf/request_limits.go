@@ -0,0 +1,199 @@
+package jamf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which limits the rate of requests.
+// It is safe for concurrent use and can be shared by the clients of every API family.
+type RateLimiter struct {
+	requestsPerSecond float64
+	burst             float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter which allows requestsPerSecond requests on average and bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+		now:               time.Now,
+	}
+}
+
+// Wait blocks until a request is allowed or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.requestsPerSecond)
+	}
+	l.last = now
+	// Reserve the token in advance, so that concurrent callers wait in turn.
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.requestsPerSecond * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// ConcurrencyLimiter limits the number of requests in flight.
+// It is safe for concurrent use and can be shared by the clients of every API family.
+type ConcurrencyLimiter struct {
+	sem chan struct{}
+}
+
+// NewConcurrencyLimiter returns a ConcurrencyLimiter which allows up to maxInFlight requests at the same time.
+func NewConcurrencyLimiter(maxInFlight int) *ConcurrencyLimiter {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	return &ConcurrencyLimiter{
+		sem: make(chan struct{}, maxInFlight),
+	}
+}
+
+// Acquire blocks until a request can be sent or the context is done.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case l.sem <- struct{}{}:
+		return nil
+	}
+}
+
+// Release marks a request acquired by Acquire as finished.
+func (l *ConcurrencyLimiter) Release() {
+	<-l.sem
+}
+
+// RequestLimits limits the rate and the concurrency of requests. Either limiter may be nil.
+type RequestLimits struct {
+	RateLimiter        *RateLimiter
+	ConcurrencyLimiter *ConcurrencyLimiter
+}
+
+// NewRequestLimits returns RequestLimits with a RateLimiter and a ConcurrencyLimiter.
+// A requestsPerSecond or maxInFlight which is not positive disables the corresponding limiter.
+func NewRequestLimits(requestsPerSecond float64, burst int, maxInFlight int) *RequestLimits {
+	limits := &RequestLimits{}
+	if requestsPerSecond > 0 {
+		limits.RateLimiter = NewRateLimiter(requestsPerSecond, burst)
+	}
+	if maxInFlight > 0 {
+		limits.ConcurrencyLimiter = NewConcurrencyLimiter(maxInFlight)
+	}
+
+	return limits
+}
+
+// acquire waits until a request is allowed, and returns a function to release it.
+func (l *RequestLimits) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.ConcurrencyLimiter != nil {
+		if err := l.ConcurrencyLimiter.Acquire(ctx); err != nil {
+			return nil, fmt.Errorf("ConcurrencyLimiter.Acquire(): %w", err)
+		}
+	}
+
+	if l.RateLimiter != nil {
+		if err := l.RateLimiter.Wait(ctx); err != nil {
+			if l.ConcurrencyLimiter != nil {
+				l.ConcurrencyLimiter.Release()
+			}
+			return nil, fmt.Errorf("RateLimiter.Wait(): %w", err)
+		}
+	}
+
+	return func() {
+		if l.ConcurrencyLimiter != nil {
+			l.ConcurrencyLimiter.Release()
+		}
+	}, nil
+}
+
+// limitedTransport applies the limits of the client to each attempt of a request.
+type limitedTransport struct {
+	client *BaseClient
+	base   http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.client.requestLimits(req.Method).acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The request is in flight until its body is read, e.g. while a file is downloaded.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnCloseBody releases the limits of a request when the response body is closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// requestLimits returns the limits applied to a request with the method.
+func (c *BaseClient) requestLimits(method string) *RequestLimits {
+	if c.WriteRequestLimits != nil && method != http.MethodGet && method != http.MethodHead {
+		return c.WriteRequestLimits
+	}
+
+	return c.RequestLimits
+}
@@ -0,0 +1,175 @@
+package jamf
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := NewRateLimiter(50, 2)
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("RateLimiter.Wait(): %v", err)
+		}
+	}
+
+	// The first 2 requests are allowed by the burst, and the others wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("RateLimiter.Wait() took %s, want at least 40ms", elapsed)
+	}
+}
+
+func TestRateLimiter_Wait_Canceled(t *testing.T) {
+	limiter := NewRateLimiter(0.1, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("RateLimiter.Wait(): %v", err)
+	}
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("RateLimiter.Wait() returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestConcurrencyLimiter_Acquire(t *testing.T) {
+	limiter := NewConcurrencyLimiter(2)
+
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.Acquire(context.Background()); err != nil {
+				t.Errorf("ConcurrencyLimiter.Acquire(): %v", err)
+				return
+			}
+			defer limiter.Release()
+
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("%d callers acquired at the same time, want at most 2", got)
+	}
+}
+
+func TestBaseClient_WriteRequestLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	client.WriteRequestLimits = NewRequestLimits(20, 1, 1)
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, _, err := client.Get(ctx, GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+		if err != nil {
+			t.Fatalf("BaseClient.Get(): %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed >= 50*time.Millisecond {
+		t.Errorf("GET requests took %s, want them not to be limited", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		resp, _, err := client.Post(ctx, PostHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+		if err != nil {
+			t.Fatalf("BaseClient.Post(): %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+	// The first request is allowed by the burst, and the others wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("POST requests took %s, want at least 100ms", elapsed)
+	}
+}
+
+func TestBaseClient_RequestLimits_Retries(t *testing.T) {
+	var count int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer teardown()
+
+	var waits int32
+	client.RequestLimits = NewRequestLimits(1000, 10, 1)
+	client.RequestLimits.RateLimiter.now = func() time.Time {
+		atomic.AddInt32(&waits, 1)
+		return time.Now()
+	}
+
+	resp, _, err := client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	// Every attempt, i.e. 503, 503 and 200, waits for the rate limiter.
+	if got, want := atomic.LoadInt32(&waits), int32(3); got != want {
+		t.Errorf("RateLimiter.Wait() was called %d times, want %d", got, want)
+	}
+}
+
+func TestBaseClient_RequestLimits_ReleasedOnBodyClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("body"))
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	client.RequestLimits = NewRequestLimits(0, 0, 1)
+
+	resp, _, err := client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+
+	// The first request is still in flight, because its body is not closed yet.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Get(ctx, GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("BaseClient.Get() returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	_ = resp.Body.Close()
+	resp, _, err = client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+}
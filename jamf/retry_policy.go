@@ -0,0 +1,190 @@
+package jamf
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryPolicy determines which requests are retried and how long to wait between the attempts.
+// It is configured once on BaseClient and shared by all requests, so it must not be modified after the client is used.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// WaitMin and WaitMax bound the exponential backoff between the attempts.
+	WaitMin time.Duration
+	WaitMax time.Duration
+	// RetryableStatusCodes are the statuses retried for the idempotent methods.
+	RetryableStatusCodes []int
+	// IdempotentMethods are the methods which can be replayed safely, e.g. after a connection error.
+	IdempotentMethods []string
+	// NonIdempotentRetryableStatusCodes are the statuses retried for the other methods, e.g. POST.
+	// They should be the ones meaning that the request was rejected before being processed.
+	NonIdempotentRetryableStatusCodes []int
+	// RespectRetryAfter makes the client wait as long as the Retry-After header of 429 and 503 responses.
+	RespectRetryAfter bool
+	// MaxRetryAfter caps the wait given by the Retry-After header. Zero means no cap.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when BaseClient.RetryPolicy is nil.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: DefaultRetryMax,
+		WaitMin:    1 * time.Second,
+		WaitMax:    30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusFailedDependency,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		IdempotentMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+		NonIdempotentRetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusServiceUnavailable,
+		},
+		RespectRetryAfter: true,
+		MaxRetryAfter:     5 * time.Minute,
+	}
+}
+
+// requestState is the state of a request shared with the retry policy through the context.
+type requestState struct {
+	method                 string
+	consistencyFailureFunc ConsistencyFailureFunc
+	retries                int
+}
+
+type requestStateKey struct{}
+
+func withRequestState(ctx context.Context, state *requestState) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, state)
+}
+
+func requestStateFromContext(ctx context.Context) *requestState {
+	state, _ := ctx.Value(requestStateKey{}).(*requestState)
+	return state
+}
+
+func (c *BaseClient) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
+	}
+	return defaultRetryPolicy
+}
+
+var defaultRetryPolicy = DefaultRetryPolicy()
+
+// checkRetry is set to retryablehttp.Client.CheckRetry once, and reads the state of each request from its context.
+func (c *BaseClient) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// do not retry on context.Canceled or context.DeadlineExceeded
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	state := requestStateFromContext(ctx)
+	if state == nil || c.DisableRetries {
+		return false, nil
+	}
+
+	policy := c.retryPolicy()
+	if state.retries >= policy.MaxRetries || !policy.shouldRetry(ctx, state, resp, err) {
+		return false, nil
+	}
+	state.retries++
+	c.logRetry(resp, state, err)
+
+	return true, nil
+}
+
+func (p *RetryPolicy) shouldRetry(ctx context.Context, state *requestState, resp *http.Response, err error) bool {
+	idempotent := containsString(p.IdempotentMethods, state.method)
+
+	if err != nil {
+		if !idempotent {
+			// The request may have reached the server, so it is not replayed.
+			return false
+		}
+		// retryablehttp.DefaultRetryPolicy classifies whether the error is recoverable.
+		shouldRetry, _ := retryablehttp.DefaultRetryPolicy(ctx, nil, err)
+		return shouldRetry
+	}
+
+	if state.consistencyFailureFunc != nil && state.consistencyFailureFunc(resp) {
+		return true
+	}
+
+	if idempotent {
+		return containsStatusCode(p.RetryableStatusCodes, resp.StatusCode)
+	}
+	return containsStatusCode(p.NonIdempotentRetryableStatusCodes, resp.StatusCode)
+}
+
+// backoff is set to retryablehttp.Client.Backoff once.
+func (c *BaseClient) backoff(_, _ time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	policy := c.retryPolicy()
+
+	if policy.RespectRetryAfter && resp != nil {
+		if wait, ok := parseRetryAfter(resp); ok {
+			if policy.MaxRetryAfter > 0 && wait > policy.MaxRetryAfter {
+				wait = policy.MaxRetryAfter
+			}
+			return wait
+		}
+	}
+
+	mult := math.Pow(2, float64(attemptNum)) * float64(policy.WaitMin)
+	wait := time.Duration(mult)
+	if float64(wait) != mult || wait > policy.WaitMax {
+		wait = policy.WaitMax
+	}
+	return wait
+}
+
+// parseRetryAfter parses the Retry-After header of 429 and 503 responses, which is either seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func containsString(expected []string, actual string) bool {
+	for _, v := range expected {
+		if actual == v {
+			return true
+		}
+	}
+
+	return false
+}
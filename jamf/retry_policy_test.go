@@ -0,0 +1,199 @@
+package jamf
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc) (*BaseClient, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	u, _ := url.Parse(server.URL)
+	client := NewBaseClient(u)
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.WaitMin = time.Millisecond
+	client.RetryPolicy.WaitMax = 10 * time.Millisecond
+
+	return client, server.Close
+}
+
+func TestBaseClient_RetryAfter(t *testing.T) {
+	var count int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer teardown()
+
+	start := time.Now()
+	resp, _, err := client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := atomic.LoadInt32(&count); got != 2 {
+		t.Errorf("server received %d requests, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("BaseClient.Get() took %s, want to wait at least 1s given by Retry-After", elapsed)
+	}
+}
+
+func TestBaseClient_RetryAfter_MaxRetryAfter(t *testing.T) {
+	var count int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer teardown()
+	client.RetryPolicy.MaxRetryAfter = 10 * time.Millisecond
+
+	resp, _, err := client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := atomic.LoadInt32(&count); got != 2 {
+		t.Errorf("server received %d requests, want 2", got)
+	}
+}
+
+func TestBaseClient_RetryPolicy_NonIdempotent(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		expectedCount int32
+	}{
+		{name: "internal server error is not retried", status: http.StatusInternalServerError, expectedCount: 1},
+		{name: "too many requests is retried", status: http.StatusTooManyRequests, expectedCount: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int32
+			client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&count, 1)
+				w.WriteHeader(tt.status)
+			})
+			defer teardown()
+			client.RetryPolicy.MaxRetries = 2
+
+			_, _, err := client.Post(context.Background(), PostHttpRequestInput{ValidStatusCodes: []int{http.StatusCreated}})
+			if !HasStatusCode(err, tt.status) {
+				t.Errorf("BaseClient.Post() returned %v, want status %d", err, tt.status)
+			}
+			if got := atomic.LoadInt32(&count); got != tt.expectedCount {
+				t.Errorf("server received %d requests, want %d", got, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestBaseClient_RetryPolicy_ConsistencyFailureFunc(t *testing.T) {
+	var count int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer teardown()
+
+	resp, _, err := client.Get(context.Background(), GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		ConsistencyFailureFunc: func(resp *http.Response) bool {
+			return resp.StatusCode == http.StatusNotFound
+		},
+	})
+	if err != nil {
+		t.Fatalf("BaseClient.Get(): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got := atomic.LoadInt32(&count); got != 3 {
+		t.Errorf("server received %d requests, want 3", got)
+	}
+}
+
+func TestBaseClient_DisableRetries(t *testing.T) {
+	var count int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer teardown()
+	client.DisableRetries = true
+
+	_, _, err := client.Get(context.Background(), GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK}})
+	if !HasStatusCode(err, http.StatusServiceUnavailable) {
+		t.Errorf("BaseClient.Get() returned %v, want status %d", err, http.StatusServiceUnavailable)
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestBaseClient_RetryPolicy_Concurrent(t *testing.T) {
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/consistent" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer teardown()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			input := GetHttpRequestInput{ValidStatusCodes: []int{http.StatusOK, http.StatusNotFound}, Uri: Uri{Entity: "/consistent"}}
+			if i%2 == 0 {
+				input = GetHttpRequestInput{
+					ValidStatusCodes: []int{http.StatusOK, http.StatusNotFound},
+					Uri:              Uri{Entity: "/inconsistent"},
+					ConsistencyFailureFunc: func(resp *http.Response) bool {
+						return resp.StatusCode == http.StatusNotFound
+					},
+				}
+			}
+
+			resp, _, err := client.Get(context.Background(), input)
+			if err != nil {
+				t.Errorf("BaseClient.Get(): %v", err)
+				return
+			}
+			_ = resp.Body.Close()
+
+			// The consistency failure of another request must not affect this one.
+			expected := http.StatusOK
+			if i%2 == 0 {
+				expected = http.StatusNotFound
+			}
+			if resp.StatusCode != expected {
+				t.Errorf("BaseClient.Get() returned status %d, want %d", resp.StatusCode, expected)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
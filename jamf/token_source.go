@@ -0,0 +1,175 @@
+package jamf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTokenRenewBefore is how long before its expiration a cached token is renewed.
+	DefaultTokenRenewBefore = 5 * time.Minute
+)
+
+// Token is a bearer token issued by Jamf Pro.
+type Token struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// TokenSource supplies bearer tokens used to authorize requests.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns a token which can be sent in the Authorization header.
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards the token so that the next call to Token does not return it again.
+	Invalidate(token string)
+}
+
+// Authorizer is a Jamf Pro identity which authenticates the clients of every API family,
+// e.g. jamfproapi.Authorizer or jamfproapi.ClientCredentialsAuthorizer.
+type Authorizer interface {
+	// TokenSource returns the TokenSource which authorizes the Classic API and the Jamf Pro API.
+	// It must return the same TokenSource every time, so that the clients share a single token.
+	TokenSource() TokenSource
+	// BasicAuth returns the username and the password which the informal API requires.
+	// ok is false when the identity has no password, e.g. an API client.
+	BasicAuth() (username string, password string, ok bool)
+}
+
+// TokenProvider acquires and renews tokens for a CachedTokenSource.
+type TokenProvider interface {
+	// Acquire obtains a new token.
+	Acquire(ctx context.Context) (*Token, error)
+	// Renew extends the lifetime of the token, e.g. by calling /v1/auth/keep-alive.
+	// Providers that cannot renew tokens should return ErrTokenRenewalNotSupported.
+	Renew(ctx context.Context, token *Token) (*Token, error)
+}
+
+// TokenRevoker is implemented by a TokenProvider which can invalidate tokens at the server side.
+type TokenRevoker interface {
+	Revoke(ctx context.Context, token *Token) error
+}
+
+// RevocableTokenSource is a TokenSource whose token can be invalidated at the server side.
+// BaseClient.Close revokes the token of a RevocableTokenSource.
+type RevocableTokenSource interface {
+	TokenSource
+	Revoke(ctx context.Context) error
+}
+
+// ErrTokenRenewalNotSupported is returned by a TokenProvider which cannot renew tokens.
+var ErrTokenRenewalNotSupported = errors.New("token renewal is not supported")
+
+// StaticTokenSource is a TokenSource which always returns the same token.
+type StaticTokenSource string
+
+// Token returns the static token.
+func (s StaticTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// Invalidate does nothing, because a static token cannot be replaced.
+func (s StaticTokenSource) Invalidate(_ string) {}
+
+// CachedTokenSource is a TokenSource which caches a token obtained from a TokenProvider,
+// renews it before it expires and acquires a new one once it has been invalidated.
+type CachedTokenSource struct {
+	provider TokenProvider
+
+	// RenewBefore is how long before the expiration the token is renewed.
+	// When the lifetime of the token is shorter than twice this duration, the half of the lifetime is used instead.
+	RenewBefore time.Duration
+
+	// Now returns the current time which decides when the token is renewed. It is time.Now by default.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	token   *Token
+	renewAt time.Time
+}
+
+// NewCachedTokenSource returns a CachedTokenSource which obtains tokens from the provider.
+func NewCachedTokenSource(provider TokenProvider) *CachedTokenSource {
+	return &CachedTokenSource{
+		provider:    provider,
+		RenewBefore: DefaultTokenRenewBefore,
+		Now:         time.Now,
+	}
+}
+
+// Token returns the cached token, renewing or acquiring it when needed.
+func (s *CachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.Now()
+	if s.token != nil && now.Before(s.renewAt) {
+		return s.token.AccessToken, nil
+	}
+
+	if s.token != nil && now.Before(s.token.ExpiresAt) {
+		token, err := s.provider.Renew(ctx, s.token)
+		if err == nil {
+			s.setToken(token)
+			return token.AccessToken, nil
+		}
+		// The token may have been invalidated at the server side, so fall back to acquiring a new one.
+	}
+
+	token, err := s.provider.Acquire(ctx)
+	if err != nil {
+		return "", fmt.Errorf("TokenProvider.Acquire(): %w", err)
+	}
+	s.setToken(token)
+
+	return token.AccessToken, nil
+}
+
+// Invalidate discards the cached token if it is the given one.
+func (s *CachedTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == token {
+		s.token = nil
+	}
+}
+
+// Revoke invalidates the cached token at the server side when the provider is a TokenRevoker, and discards it.
+// A token is acquired again when Token is called after that.
+func (s *CachedTokenSource) Revoke(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil
+	}
+
+	token := s.token
+	s.token = nil
+	if !s.Now().Before(token.ExpiresAt) {
+		return nil
+	}
+
+	if revoker, ok := s.provider.(TokenRevoker); ok {
+		if err := revoker.Revoke(ctx, token); err != nil {
+			return fmt.Errorf("TokenRevoker.Revoke(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *CachedTokenSource) setToken(token *Token) {
+	lifetime := token.ExpiresAt.Sub(s.Now())
+	renewBefore := s.RenewBefore
+	if lifetime < renewBefore*2 {
+		renewBefore = lifetime / 2
+	}
+
+	s.token = token
+	s.renewAt = token.ExpiresAt.Add(-renewBefore)
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
+	"sync"
 
 	"github.com/kenchan0130/go-jamf-pro/jamf"
 	"github.com/kenchan0130/go-jamf-pro/utils"
@@ -15,10 +17,31 @@ import (
 
 type APIAuthenticationService service
 
+// Authorizer is a Jamf Pro identity of a username and a password.
+// Passing it to the clients of every API family with jamf.WithAuthorizer authenticates them with a single shared token,
+// and the informal API with the username and the password.
 type Authorizer struct {
+	// BaseURL is the base endpoint for Jamf Pro, e.g. https://yourdomain.jamfcloud.com
 	BaseURL  *url.URL
 	Username string
 	Password string
+
+	once        sync.Once
+	tokenSource jamf.TokenSource
+}
+
+// TokenSource returns the token source of NewBasicAuthTokenSource, which is created on the first call and shared after that.
+func (a *Authorizer) TokenSource() jamf.TokenSource {
+	a.once.Do(func() {
+		a.tokenSource = newBasicAuthTokenSource(newClient(a.BaseURL), a.Username, a.Password)
+	})
+
+	return a.tokenSource
+}
+
+// BasicAuth returns the username and the password.
+func (a *Authorizer) BasicAuth() (string, string, bool) {
+	return a.Username, a.Password, a.Username != ""
 }
 
 type AuthToken struct {
@@ -26,8 +49,173 @@ type AuthToken struct {
 	Expires *string `json:"expires,omitempty"`
 }
 
+type AuthorizationInfo struct {
+	Account            *AuthorizationInfoAccount        `json:"account,omitempty"`
+	AccountGroups      *[]AuthorizationInfoAccountGroup `json:"accountGroups,omitempty"`
+	Sites              *[]AuthorizationInfoSite         `json:"sites,omitempty"`
+	AuthenticationType *string                          `json:"authenticationType,omitempty"`
+}
+
+type AuthorizationInfoAccount struct {
+	ID               *string                              `json:"id,omitempty"`
+	Username         *string                              `json:"username,omitempty"`
+	RealName         *string                              `json:"realName,omitempty"`
+	Email            *string                              `json:"email,omitempty"`
+	Preferences      *AuthorizationInfoAccountPreferences `json:"preferences,omitempty"`
+	MultiSiteAdmin   *bool                                `json:"multiSiteAdmin,omitempty"`
+	AccessLevel      *AuthorizationInfoAccessLevel        `json:"accessLevel,omitempty"`
+	PrivilegeSet     *AuthorizationInfoPrivilegeSet       `json:"privilegeSet,omitempty"`
+	PrivilegesBySite *map[string][]string                 `json:"privilegesBySite,omitempty"`
+	GroupIDs         *[]int                               `json:"groupIds,omitempty"`
+	CurrentSiteID    *int                                 `json:"currentSiteId,omitempty"`
+}
+
+type AuthorizationInfoAccountPreferences struct {
+	Language                    *string `json:"language,omitempty"`
+	DateFormat                  *string `json:"dateFormat,omitempty"`
+	Region                      *string `json:"region,omitempty"`
+	Timezone                    *string `json:"timezone,omitempty"`
+	DisableRelativeDates        *bool   `json:"disableRelativeDates,omitempty"`
+	DisablePageLeaveCheck       *bool   `json:"disablePageLeaveCheck,omitempty"`
+	DisableShortcutsTooltips    *bool   `json:"disableShortcutsTooltips,omitempty"`
+	DisableTablePagination      *bool   `json:"disableTablePagination,omitempty"`
+	ConfigProfilesSortingMethod *string `json:"configProfilesSortingMethod,omitempty"`
+}
+
+type AuthorizationInfoAccountGroup struct {
+	AccessLevel   *AuthorizationInfoAccessLevel  `json:"accessLevel,omitempty"`
+	PrivilegeSet  *AuthorizationInfoPrivilegeSet `json:"privilegeSet,omitempty"`
+	SiteID        *int                           `json:"siteId,omitempty"`
+	Privileges    *[]string                      `json:"privileges,omitempty"`
+	MemberUserIDs *[]int                         `json:"memberUserIds,omitempty"`
+}
+
+type AuthorizationInfoAccessLevel string
+
+const (
+	AuthorizationInfoAccessLevelFullAccess  AuthorizationInfoAccessLevel = "FullAccess"
+	AuthorizationInfoAccessLevelSiteAccess  AuthorizationInfoAccessLevel = "SiteAccess"
+	AuthorizationInfoAccessLevelGroupAccess AuthorizationInfoAccessLevel = "GroupBasedAccess"
+)
+
+type AuthorizationInfoPrivilegeSet string
+
+const (
+	AuthorizationInfoPrivilegeSetAdministrator AuthorizationInfoPrivilegeSet = "ADMINISTRATOR"
+	AuthorizationInfoPrivilegeSetAuditor       AuthorizationInfoPrivilegeSet = "AUDITOR"
+	AuthorizationInfoPrivilegeSetEnrollment    AuthorizationInfoPrivilegeSet = "ENROLLMENT"
+	AuthorizationInfoPrivilegeSetCustom        AuthorizationInfoPrivilegeSet = "CUSTOM"
+)
+
+type AuthorizationInfoSite struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// MissingPrivilegesError is returned when the current session lacks privileges which are required.
+type MissingPrivilegesError struct {
+	Privileges []string
+}
+
+func (e *MissingPrivilegesError) Error() string {
+	return fmt.Sprintf("missing privileges: %s", strings.Join(e.Privileges, ", "))
+}
+
+// MissingPrivileges returns the privileges which are granted neither to the account in any site nor to its groups.
+func (a *AuthorizationInfo) MissingPrivileges(privileges ...string) []string {
+	granted := map[string]bool{}
+	if a.Account != nil && a.Account.PrivilegesBySite != nil {
+		for _, sitePrivileges := range *a.Account.PrivilegesBySite {
+			for _, p := range sitePrivileges {
+				granted[p] = true
+			}
+		}
+	}
+	if a.AccountGroups != nil {
+		for _, group := range *a.AccountGroups {
+			if group.Privileges == nil {
+				continue
+			}
+			for _, p := range *group.Privileges {
+				granted[p] = true
+			}
+		}
+	}
+
+	var missing []string
+	for _, p := range privileges {
+		if !granted[p] {
+			missing = append(missing, p)
+		}
+	}
+
+	return missing
+}
+
 const apiAuthenticationPath = "/v1/auth"
 
+// Get returns the authorization details of the current session, e.g. the account, privileges and sites.
+func (s *APIAuthenticationService) Get(ctx context.Context) (*AuthorizationInfo, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: apiAuthenticationPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var authorizationInfo AuthorizationInfo
+	if err := json.Unmarshal(respBody, &authorizationInfo); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &authorizationInfo, resp, nil
+}
+
+func (s *APIAuthenticationService) InvalidateToken(ctx context.Context) (*jamf.Response, error) {
+	return s.invalidateToken(ctx, nil)
+}
+
+func (s *APIAuthenticationService) invalidateToken(ctx context.Context, requestMiddlewareFunc jamf.RequestMiddlewareFunc) (*jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes:      []int{http.StatusNoContent},
+		RequestMiddlewareFunc: requestMiddlewareFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(apiAuthenticationPath, "invalidate-token"),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// RequirePrivileges returns a *MissingPrivilegesError when the current session lacks any of the privileges.
+// It can be used to check the privileges before starting a batch job.
+func (s *APIAuthenticationService) RequirePrivileges(ctx context.Context, privileges ...string) (*jamf.Response, error) {
+	authorizationInfo, resp, err := s.Get(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	if missing := authorizationInfo.MissingPrivileges(privileges...); len(missing) > 0 {
+		return resp, &MissingPrivilegesError{Privileges: missing}
+	}
+
+	return resp, nil
+}
+
 func (s *APIAuthenticationService) Token(ctx context.Context, username string, password string) (*AuthToken, *jamf.Response, error) {
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
 		ValidStatusCodes: []int{http.StatusOK},
@@ -40,18 +228,49 @@ func (s *APIAuthenticationService) Token(ctx context.Context, username string, p
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var authToken AuthToken
+	if err := json.Unmarshal(respBody, &authToken); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &authToken, resp, nil
+}
+
+func (s *APIAuthenticationService) KeepAlive(ctx context.Context) (*AuthToken, *jamf.Response, error) {
+	return s.keepAlive(ctx, nil)
+}
+
+func (s *APIAuthenticationService) keepAlive(ctx context.Context, requestMiddlewareFunc jamf.RequestMiddlewareFunc) (*AuthToken, *jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes:      []int{http.StatusOK},
+		RequestMiddlewareFunc: requestMiddlewareFunc,
+		Uri: jamf.Uri{
+			Entity: path.Join(apiAuthenticationPath, "keep-alive"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var authToken AuthToken
 	if err := json.Unmarshal(respBody, &authToken); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &authToken, resp, nil
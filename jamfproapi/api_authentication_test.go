@@ -0,0 +1,154 @@
+package jamfproapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const authorizationInfoJSON = `{
+	"account": {
+		"id": "1",
+		"username": "admin",
+		"realName": "IT Bob",
+		"email": "ITBob@Jamf.com",
+		"preferences": {
+			"language": "en",
+			"dateFormat": "MM/dd/yyyy",
+			"region": "Europe",
+			"timezone": "Etc/GMT",
+			"disableRelativeDates": false
+		},
+		"multiSiteAdmin": true,
+		"accessLevel": "FullAccess",
+		"privilegeSet": "CUSTOM",
+		"privilegesBySite": {
+			"-1": ["Read Scripts", "Update Scripts"]
+		},
+		"groupIds": [1],
+		"currentSiteId": -1
+	},
+	"accountGroups": [
+		{
+			"accessLevel": "FullAccess",
+			"privilegeSet": "CUSTOM",
+			"siteId": -1,
+			"privileges": ["Read Categories"],
+			"memberUserIds": [1]
+		}
+	],
+	"sites": [
+		{
+			"id": "1",
+			"name": "Eau Claire"
+		}
+	],
+	"authenticationType": "JSS"
+}`
+
+func TestAPIAuthenticationService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(authorizationInfoJSON)))
+	})
+
+	ctx := context.Background()
+	authorizationInfo, _, err := client.APIAuthentication.Get(ctx)
+	if err != nil {
+		t.Fatalf("APIAuthentication.Get(): %v", err)
+	}
+
+	want := &AuthorizationInfo{
+		Account: &AuthorizationInfoAccount{
+			ID:       ptr("1"),
+			Username: ptr("admin"),
+			RealName: ptr("IT Bob"),
+			Email:    ptr("ITBob@Jamf.com"),
+			Preferences: &AuthorizationInfoAccountPreferences{
+				Language:             ptr("en"),
+				DateFormat:           ptr("MM/dd/yyyy"),
+				Region:               ptr("Europe"),
+				Timezone:             ptr("Etc/GMT"),
+				DisableRelativeDates: ptr(false),
+			},
+			MultiSiteAdmin: ptr(true),
+			AccessLevel:    ptr(AuthorizationInfoAccessLevelFullAccess),
+			PrivilegeSet:   ptr(AuthorizationInfoPrivilegeSetCustom),
+			PrivilegesBySite: &map[string][]string{
+				"-1": {"Read Scripts", "Update Scripts"},
+			},
+			GroupIDs:      &[]int{1},
+			CurrentSiteID: ptr(-1),
+		},
+		AccountGroups: &[]AuthorizationInfoAccountGroup{
+			{
+				AccessLevel:   ptr(AuthorizationInfoAccessLevelFullAccess),
+				PrivilegeSet:  ptr(AuthorizationInfoPrivilegeSetCustom),
+				SiteID:        ptr(-1),
+				Privileges:    &[]string{"Read Categories"},
+				MemberUserIDs: &[]int{1},
+			},
+		},
+		Sites: &[]AuthorizationInfoSite{
+			{
+				ID:   ptr("1"),
+				Name: ptr("Eau Claire"),
+			},
+		},
+		AuthenticationType: ptr("JSS"),
+	}
+	if !cmp.Equal(authorizationInfo, want) {
+		t.Fatalf("APIAuthentication.Get() returned %s, want %s", formatWithSpew(authorizationInfo), formatWithSpew(want))
+	}
+}
+
+func TestAPIAuthenticationService_InvalidateToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "invalidate-token"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.APIAuthentication.InvalidateToken(ctx)
+	if err != nil {
+		t.Fatalf("APIAuthentication.InvalidateToken(): %v", err)
+	}
+}
+
+func TestAPIAuthenticationService_RequirePrivileges(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(authorizationInfoJSON)))
+	})
+
+	ctx := context.Background()
+	if _, err := client.APIAuthentication.RequirePrivileges(ctx, "Read Scripts", "Read Categories"); err != nil {
+		t.Fatalf("APIAuthentication.RequirePrivileges(): %v", err)
+	}
+
+	_, err := client.APIAuthentication.RequirePrivileges(ctx, "Read Scripts", "Delete Scripts")
+	var missingPrivilegesErr *MissingPrivilegesError
+	if !errors.As(err, &missingPrivilegesErr) {
+		t.Fatalf("APIAuthentication.RequirePrivileges() returned %v, want *MissingPrivilegesError", err)
+	}
+	if want := []string{"Delete Scripts"}; !cmp.Equal(missingPrivilegesErr.Privileges, want) {
+		t.Errorf("MissingPrivilegesError.Privileges is %s, want %s", formatWithSpew(missingPrivilegesErr.Privileges), formatWithSpew(want))
+	}
+}
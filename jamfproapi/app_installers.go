@@ -0,0 +1,409 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+// AppInstallersService manages the Jamf App Installers, i.e. the titles of the Jamf App Catalog and their deployments.
+type AppInstallersService service
+
+type AppInstallerTitle struct {
+	ID                     *string `json:"id,omitempty"`
+	BundleID               *string `json:"bundleId,omitempty"`
+	TitleName              *string `json:"titleName,omitempty"`
+	Publisher              *string `json:"publisher,omitempty"`
+	IconURL                *string `json:"iconUrl,omitempty"`
+	Version                *string `json:"version,omitempty"`
+	SizeInBytes            *int64  `json:"sizeInBytes,omitempty"`
+	MinimumOSVersion       *string `json:"minimumOsVersion,omitempty"`
+	Language               *string `json:"language,omitempty"`
+	AvailabilityDate       *string `json:"availabilityDate,omitempty"`
+	PackageSigningIdentity *string `json:"packageSigningIdentity,omitempty"`
+	LaunchDaemonIncluded   *bool   `json:"launchDaemonIncluded,omitempty"`
+	NotificationAvailable  *bool   `json:"notificationAvailable,omitempty"`
+	SuppressAutoUpdate     *bool   `json:"suppressAutoUpdate,omitempty"`
+}
+
+type ListAppInstallerTitle struct {
+	TotalCount *int                 `json:"totalCount,omitempty"`
+	Titles     *[]AppInstallerTitle `json:"results,omitempty"`
+}
+
+type AppInstallerDeployment struct {
+	ID                              *string                                     `json:"id,omitempty"`
+	Name                            *string                                     `json:"name,omitempty"`
+	Enabled                         *bool                                       `json:"enabled,omitempty"`
+	AppTitleID                      *string                                     `json:"appTitleId,omitempty"`
+	DeploymentType                  *AppInstallerDeploymentType                 `json:"deploymentType,omitempty"`
+	UpdateBehavior                  *AppInstallerDeploymentUpdateBehavior       `json:"updateBehavior,omitempty"`
+	CategoryID                      *string                                     `json:"categoryId,omitempty"`
+	SiteID                          *string                                     `json:"siteId,omitempty"`
+	SmartGroupID                    *string                                     `json:"smartGroupId,omitempty"`
+	InstallPredefinedConfigProfiles *bool                                       `json:"installPredefinedConfigProfiles,omitempty"`
+	TitleAvailableInAIS             *bool                                       `json:"titleAvailableInAis,omitempty"`
+	TriggerAdminNotifications       *bool                                       `json:"triggerAdminNotifications,omitempty"`
+	NotificationSettings            *AppInstallerDeploymentNotificationSettings `json:"notificationSettings,omitempty"`
+	SelfServiceSettings             *AppInstallerDeploymentSelfServiceSettings  `json:"selfServiceSettings,omitempty"`
+	SelectedVersion                 *string                                     `json:"selectedVersion,omitempty"`
+	LatestAvailableVersion          *string                                     `json:"latestAvailableVersion,omitempty"`
+	VersionRemoved                  *bool                                       `json:"versionRemoved,omitempty"`
+}
+
+type AppInstallerDeploymentType string
+
+const (
+	AppInstallerDeploymentTypeInstallAutomatically AppInstallerDeploymentType = "INSTALL_AUTOMATICALLY"
+	AppInstallerDeploymentTypeSelfService          AppInstallerDeploymentType = "SELF_SERVICE"
+)
+
+type AppInstallerDeploymentUpdateBehavior string
+
+const (
+	AppInstallerDeploymentUpdateBehaviorAutomatic AppInstallerDeploymentUpdateBehavior = "AUTOMATIC"
+	AppInstallerDeploymentUpdateBehaviorManual    AppInstallerDeploymentUpdateBehavior = "MANUAL"
+)
+
+// AppInstallerDeploymentNotificationSettings is the notifications shown to users when the app is installed or updated.
+type AppInstallerDeploymentNotificationSettings struct {
+	NotificationMessage *string `json:"notificationMessage,omitempty"`
+	// NotificationInterval is the number of hours between the notifications.
+	NotificationInterval *int    `json:"notificationInterval,omitempty"`
+	DeadlineMessage      *string `json:"deadlineMessage,omitempty"`
+	// Deadline is the number of hours until the app is quit to be updated.
+	Deadline *int `json:"deadline,omitempty"`
+	// QuitDelay is the number of minutes between the deadline message and quitting the app.
+	QuitDelay       *int    `json:"quitDelay,omitempty"`
+	CompleteMessage *string `json:"completeMessage,omitempty"`
+	Relaunch        *bool   `json:"relaunch,omitempty"`
+	Suppress        *bool   `json:"suppress,omitempty"`
+}
+
+type AppInstallerDeploymentSelfServiceSettings struct {
+	IncludeInFeaturedCategory   *bool                                                `json:"includeInFeaturedCategory,omitempty"`
+	IncludeInComplianceCategory *bool                                                `json:"includeInComplianceCategory,omitempty"`
+	ForceViewDescription        *bool                                                `json:"forceViewDescription,omitempty"`
+	Description                 *string                                              `json:"description,omitempty"`
+	Categories                  *[]AppInstallerDeploymentSelfServiceSettingsCategory `json:"categories,omitempty"`
+}
+
+type AppInstallerDeploymentSelfServiceSettingsCategory struct {
+	ID       *string `json:"id,omitempty"`
+	Featured *bool   `json:"featured,omitempty"`
+}
+
+type ListAppInstallerDeployment struct {
+	TotalCount  *int                      `json:"totalCount,omitempty"`
+	Deployments *[]AppInstallerDeployment `json:"results,omitempty"`
+}
+
+// AppInstallerDeploymentComputer is the deployment status of a computer.
+type AppInstallerDeploymentComputer struct {
+	ComputerID   *string `json:"computerId,omitempty"`
+	ComputerName *string `json:"computerName,omitempty"`
+	Version      *string `json:"version,omitempty"`
+	Status       *string `json:"status,omitempty"`
+	LastUpdate   *string `json:"lastUpdate,omitempty"`
+}
+
+type ListAppInstallerDeploymentComputer struct {
+	TotalCount *int                              `json:"totalCount,omitempty"`
+	Computers  *[]AppInstallerDeploymentComputer `json:"results,omitempty"`
+}
+
+const (
+	appInstallersTitlesPath      = "/v1/app-installers/titles"
+	appInstallersDeploymentsPath = "/v1/app-installers/deployments"
+)
+
+func (s *AppInstallersService) CreateDeployment(ctx context.Context, deployment *AppInstallerDeployment) (*string, *jamf.Response, error) {
+	if deployment.Name == nil {
+		return nil, nil, errors.New("AppInstallersService.CreateDeployment(): cannot create deployment with nil Name")
+	}
+	if deployment.AppTitleID == nil {
+		return nil, nil, errors.New("AppInstallersService.CreateDeployment(): cannot create deployment with nil AppTitleID")
+	}
+
+	body, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: appInstallersDeploymentsPath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		ID   string `json:"id"`
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &data.ID, resp, nil
+}
+
+func (s *AppInstallersService) DeleteDeployment(ctx context.Context, deploymentID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(appInstallersDeploymentsPath, deploymentID),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *AppInstallersService) GetDeployment(ctx context.Context, deploymentID string) (*AppInstallerDeployment, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(appInstallersDeploymentsPath, deploymentID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var deployment AppInstallerDeployment
+	if err := json.Unmarshal(respBody, &deployment); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &deployment, resp, nil
+}
+
+func (s *AppInstallersService) GetTitle(ctx context.Context, titleID string) (*AppInstallerTitle, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(appInstallersTitlesPath, titleID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var title AppInstallerTitle
+	if err := json.Unmarshal(respBody, &title); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &title, resp, nil
+}
+
+// ListDeploymentComputers returns the deployment status of the computers in the scope of the deployment.
+func (s *AppInstallersService) ListDeploymentComputers(ctx context.Context, deploymentID string, options ListOptions) (*ListAppInstallerDeploymentComputer, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(appInstallersDeploymentsPath, deploymentID, "computers"),
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listComputer ListAppInstallerDeploymentComputer
+	if err := json.Unmarshal(respBody, &listComputer); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listComputer, resp, nil
+}
+
+// ListAllDeploymentComputers fetches all pages of the deployment status of the computers.
+func (s *AppInstallersService) ListAllDeploymentComputers(ctx context.Context, deploymentID string, options ListAllOptions) ([]AppInstallerDeploymentComputer, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]AppInstallerDeploymentComputer, int, error) {
+		list, _, err := s.ListDeploymentComputers(ctx, deploymentID, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Computers, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+func (s *AppInstallersService) ListDeployments(ctx context.Context, options ListOptions) (*ListAppInstallerDeployment, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: appInstallersDeploymentsPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listDeployment ListAppInstallerDeployment
+	if err := json.Unmarshal(respBody, &listDeployment); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listDeployment, resp, nil
+}
+
+// ListAllDeployments fetches all pages of the deployments.
+func (s *AppInstallersService) ListAllDeployments(ctx context.Context, options ListAllOptions) ([]AppInstallerDeployment, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]AppInstallerDeployment, int, error) {
+		list, _, err := s.ListDeployments(ctx, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Deployments, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+// ListTitles returns the titles of the Jamf App Catalog, e.g. filtered with bundleId=="com.google.Chrome".
+func (s *AppInstallersService) ListTitles(ctx context.Context, options ListOptions) (*ListAppInstallerTitle, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: appInstallersTitlesPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listTitle ListAppInstallerTitle
+	if err := json.Unmarshal(respBody, &listTitle); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listTitle, resp, nil
+}
+
+// ListAllTitles fetches all pages of the titles.
+func (s *AppInstallersService) ListAllTitles(ctx context.Context, options ListAllOptions) ([]AppInstallerTitle, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]AppInstallerTitle, int, error) {
+		list, _, err := s.ListTitles(ctx, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Titles, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+func (s *AppInstallersService) UpdateDeployment(ctx context.Context, deployment *AppInstallerDeployment) (*AppInstallerDeployment, *jamf.Response, error) {
+	if deployment.ID == nil {
+		return nil, nil, errors.New("AppInstallersService.UpdateDeployment(): cannot update deployment with nil ID")
+	}
+
+	body, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(appInstallersDeploymentsPath, *deployment.ID),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Put(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newDeployment AppInstallerDeployment
+	if err := json.Unmarshal(respBody, &newDeployment); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newDeployment, resp, nil
+}
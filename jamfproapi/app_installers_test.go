@@ -0,0 +1,395 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAppInstallersService_CreateDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"name":"Google Chrome","enabled":true,"appTitleId":"0BA","deploymentType":"INSTALL_AUTOMATICALLY","updateBehavior":"AUTOMATIC","smartGroupId":"1","notificationSettings":{"deadline":24,"quitDelay":5,"relaunch":true}}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v1/app-installers/deployments/1"
+		}`)))
+	})
+
+	ctx := context.Background()
+	deploymentID, _, err := client.AppInstallers.CreateDeployment(ctx, &AppInstallerDeployment{
+		Name:           ptr("Google Chrome"),
+		Enabled:        ptr(true),
+		AppTitleID:     ptr("0BA"),
+		DeploymentType: ptr(AppInstallerDeploymentTypeInstallAutomatically),
+		UpdateBehavior: ptr(AppInstallerDeploymentUpdateBehaviorAutomatic),
+		SmartGroupID:   ptr("1"),
+		NotificationSettings: &AppInstallerDeploymentNotificationSettings{
+			Deadline:  ptr(24),
+			QuitDelay: ptr(5),
+			Relaunch:  ptr(true),
+		},
+	})
+	if err != nil {
+		t.Fatalf("AppInstallers.CreateDeployment(): %v", err)
+	}
+
+	want := ptr("1")
+	if !cmp.Equal(deploymentID, want) {
+		t.Errorf("AppInstallers.CreateDeployment() returned %s, want %s", formatWithSpew(deploymentID), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_DeleteDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.AppInstallers.DeleteDeployment(ctx, "1")
+	if err != nil {
+		t.Fatalf("AppInstallers.DeleteDeployment(): %v", err)
+	}
+}
+
+func TestAppInstallersService_GetDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"name": "Google Chrome",
+			"enabled": true,
+			"appTitleId": "0BA",
+			"deploymentType": "SELF_SERVICE",
+			"updateBehavior": "AUTOMATIC",
+			"categoryId": "-1",
+			"siteId": "-1",
+			"smartGroupId": "1",
+			"installPredefinedConfigProfiles": true,
+			"titleAvailableInAis": true,
+			"triggerAdminNotifications": false,
+			"notificationSettings": {
+				"notificationMessage": "A new update is available",
+				"notificationInterval": 24,
+				"deadlineMessage": "Update deadline approaching",
+				"deadline": 48,
+				"quitDelay": 5,
+				"completeMessage": "Update completed successfully",
+				"relaunch": true,
+				"suppress": false
+			},
+			"selfServiceSettings": {
+				"includeInFeaturedCategory": true,
+				"includeInComplianceCategory": false,
+				"forceViewDescription": false,
+				"description": "Web browser",
+				"categories": [
+					{
+						"id": "1",
+						"featured": true
+					}
+				]
+			},
+			"selectedVersion": "124.0.6367.61",
+			"latestAvailableVersion": "124.0.6367.61",
+			"versionRemoved": false
+		}`)))
+	})
+
+	ctx := context.Background()
+	deployment, _, err := client.AppInstallers.GetDeployment(ctx, "1")
+	if err != nil {
+		t.Fatalf("AppInstallers.GetDeployment(): %v", err)
+	}
+
+	want := &AppInstallerDeployment{
+		ID:                              ptr("1"),
+		Name:                            ptr("Google Chrome"),
+		Enabled:                         ptr(true),
+		AppTitleID:                      ptr("0BA"),
+		DeploymentType:                  ptr(AppInstallerDeploymentTypeSelfService),
+		UpdateBehavior:                  ptr(AppInstallerDeploymentUpdateBehaviorAutomatic),
+		CategoryID:                      ptr("-1"),
+		SiteID:                          ptr("-1"),
+		SmartGroupID:                    ptr("1"),
+		InstallPredefinedConfigProfiles: ptr(true),
+		TitleAvailableInAIS:             ptr(true),
+		TriggerAdminNotifications:       ptr(false),
+		NotificationSettings: &AppInstallerDeploymentNotificationSettings{
+			NotificationMessage:  ptr("A new update is available"),
+			NotificationInterval: ptr(24),
+			DeadlineMessage:      ptr("Update deadline approaching"),
+			Deadline:             ptr(48),
+			QuitDelay:            ptr(5),
+			CompleteMessage:      ptr("Update completed successfully"),
+			Relaunch:             ptr(true),
+			Suppress:             ptr(false),
+		},
+		SelfServiceSettings: &AppInstallerDeploymentSelfServiceSettings{
+			IncludeInFeaturedCategory:   ptr(true),
+			IncludeInComplianceCategory: ptr(false),
+			ForceViewDescription:        ptr(false),
+			Description:                 ptr("Web browser"),
+			Categories: &[]AppInstallerDeploymentSelfServiceSettingsCategory{
+				{
+					ID:       ptr("1"),
+					Featured: ptr(true),
+				},
+			},
+		},
+		SelectedVersion:        ptr("124.0.6367.61"),
+		LatestAvailableVersion: ptr("124.0.6367.61"),
+		VersionRemoved:         ptr(false),
+	}
+	if !cmp.Equal(deployment, want) {
+		t.Errorf("AppInstallers.GetDeployment() returned %s, want %s", formatWithSpew(deployment), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_GetTitle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersTitlesPath, "0BA"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "0BA",
+			"bundleId": "com.google.Chrome",
+			"titleName": "Google Chrome",
+			"publisher": "Google",
+			"iconUrl": "https://ics.services.jamfcloud.com/icon/hash_0BA",
+			"version": "124.0.6367.61",
+			"sizeInBytes": 257649664,
+			"minimumOsVersion": "10.15",
+			"language": "English",
+			"availabilityDate": "2024-04-16T00:00:00Z",
+			"packageSigningIdentity": "Developer ID Installer: Google LLC (EQHXZ8M8AV)",
+			"launchDaemonIncluded": false,
+			"notificationAvailable": true,
+			"suppressAutoUpdate": false
+		}`)))
+	})
+
+	ctx := context.Background()
+	title, _, err := client.AppInstallers.GetTitle(ctx, "0BA")
+	if err != nil {
+		t.Fatalf("AppInstallers.GetTitle(): %v", err)
+	}
+
+	want := &AppInstallerTitle{
+		ID:                     ptr("0BA"),
+		BundleID:               ptr("com.google.Chrome"),
+		TitleName:              ptr("Google Chrome"),
+		Publisher:              ptr("Google"),
+		IconURL:                ptr("https://ics.services.jamfcloud.com/icon/hash_0BA"),
+		Version:                ptr("124.0.6367.61"),
+		SizeInBytes:            ptr(int64(257649664)),
+		MinimumOSVersion:       ptr("10.15"),
+		Language:               ptr("English"),
+		AvailabilityDate:       ptr("2024-04-16T00:00:00Z"),
+		PackageSigningIdentity: ptr("Developer ID Installer: Google LLC (EQHXZ8M8AV)"),
+		LaunchDaemonIncluded:   ptr(false),
+		NotificationAvailable:  ptr(true),
+		SuppressAutoUpdate:     ptr(false),
+	}
+	if !cmp.Equal(title, want) {
+		t.Errorf("AppInstallers.GetTitle() returned %s, want %s", formatWithSpew(title), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_ListAllDeploymentComputers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath, "1", "computers"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "0":
+			_, _ = w.Write(compactJSON([]byte(`{
+				"totalCount": 2,
+				"results": [
+					{
+						"computerId": "1",
+						"computerName": "MacBook Pro",
+						"version": "124.0.6367.61",
+						"status": "INSTALLED",
+						"lastUpdate": "2024-04-17T09:00:00Z"
+					}
+				]
+			}`)))
+		case "1":
+			_, _ = w.Write(compactJSON([]byte(`{"totalCount": 2, "results": [{"computerId": "2", "status": "FAILED"}]}`)))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ctx := context.Background()
+	computers, err := client.AppInstallers.ListAllDeploymentComputers(ctx, "1", ListAllOptions{
+		ListOptions: ListOptions{PageSize: ptr(1)},
+	})
+	if err != nil {
+		t.Fatalf("AppInstallers.ListAllDeploymentComputers(): %v", err)
+	}
+
+	want := []AppInstallerDeploymentComputer{
+		{
+			ComputerID:   ptr("1"),
+			ComputerName: ptr("MacBook Pro"),
+			Version:      ptr("124.0.6367.61"),
+			Status:       ptr("INSTALLED"),
+			LastUpdate:   ptr("2024-04-17T09:00:00Z"),
+		},
+		{
+			ComputerID: ptr("2"),
+			Status:     ptr("FAILED"),
+		},
+	}
+	if !cmp.Equal(computers, want) {
+		t.Errorf("AppInstallers.ListAllDeploymentComputers() returned %s, want %s", formatWithSpew(computers), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_ListDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": "1",
+					"name": "Google Chrome",
+					"appTitleId": "0BA"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	deployments, _, err := client.AppInstallers.ListDeployments(ctx, ListOptions{})
+	if err != nil {
+		t.Fatalf("AppInstallers.ListDeployments(): %v", err)
+	}
+
+	want := &ListAppInstallerDeployment{
+		TotalCount: ptr(1),
+		Deployments: &[]AppInstallerDeployment{
+			{
+				ID:         ptr("1"),
+				Name:       ptr("Google Chrome"),
+				AppTitleID: ptr("0BA"),
+			},
+		},
+	}
+	if !cmp.Equal(deployments, want) {
+		t.Errorf("AppInstallers.ListDeployments() returned %s, want %s", formatWithSpew(deployments), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_ListTitles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersTitlesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `bundleId=="com.google.Chrome"`; got != want {
+			t.Errorf("filter = %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": "0BA",
+					"bundleId": "com.google.Chrome",
+					"titleName": "Google Chrome"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	titles, _, err := client.AppInstallers.ListTitles(ctx, ListOptions{
+		Filter: ptr(`bundleId=="com.google.Chrome"`),
+	})
+	if err != nil {
+		t.Fatalf("AppInstallers.ListTitles(): %v", err)
+	}
+
+	want := &ListAppInstallerTitle{
+		TotalCount: ptr(1),
+		Titles: &[]AppInstallerTitle{
+			{
+				ID:        ptr("0BA"),
+				BundleID:  ptr("com.google.Chrome"),
+				TitleName: ptr("Google Chrome"),
+			},
+		},
+	}
+	if !cmp.Equal(titles, want) {
+		t.Errorf("AppInstallers.ListTitles() returned %s, want %s", formatWithSpew(titles), formatWithSpew(want))
+	}
+}
+
+func TestAppInstallersService_UpdateDeployment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(appInstallersDeploymentsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte(`{"id":"1","name":"Google Chrome","updateBehavior":"MANUAL"}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"name": "Google Chrome",
+			"updateBehavior": "MANUAL"
+		}`)))
+	})
+
+	ctx := context.Background()
+	deployment, _, err := client.AppInstallers.UpdateDeployment(ctx, &AppInstallerDeployment{
+		ID:             ptr("1"),
+		Name:           ptr("Google Chrome"),
+		UpdateBehavior: ptr(AppInstallerDeploymentUpdateBehaviorManual),
+	})
+	if err != nil {
+		t.Fatalf("AppInstallers.UpdateDeployment(): %v", err)
+	}
+
+	want := &AppInstallerDeployment{
+		ID:             ptr("1"),
+		Name:           ptr("Google Chrome"),
+		UpdateBehavior: ptr(AppInstallerDeploymentUpdateBehaviorManual),
+	}
+	if !cmp.Equal(deployment, want) {
+		t.Errorf("AppInstallers.UpdateDeployment() returned %s, want %s", formatWithSpew(deployment), formatWithSpew(want))
+	}
+}
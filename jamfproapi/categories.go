@@ -40,7 +40,7 @@ func (s *CategoriesService) Create(ctx context.Context, category *Category) (*st
 
 	body, err := json.Marshal(category)
 	if err != nil {
-		return nil, nil, fmt.Errorf("json.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -52,13 +52,13 @@ func (s *CategoriesService) Create(ctx context.Context, category *Category) (*st
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, nil, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -66,7 +66,7 @@ func (s *CategoriesService) Create(ctx context.Context, category *Category) (*st
 		Href string `json:"href"`
 	}
 	if err := json.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &data.ID, resp, nil
@@ -81,7 +81,7 @@ func (s *CategoriesService) Delete(ctx context.Context, categoryID string) (*jam
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -95,7 +95,7 @@ func (s *CategoriesService) DeleteMultiple(ctx context.Context, categoryIDs []st
 
 	body, err := json.Marshal(data)
 	if err != nil {
-		return nil, fmt.Errorf("json.Marshal(): %v", err)
+		return nil, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -107,7 +107,7 @@ func (s *CategoriesService) DeleteMultiple(ctx context.Context, categoryIDs []st
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, fmt.Errorf("client.Post(): %w", err)
 	}
 
 	return resp, nil
@@ -122,18 +122,18 @@ func (s *CategoriesService) Get(ctx context.Context, categoryID string) (*Catego
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, nil, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var category Category
 	if err := json.Unmarshal(respBody, &category); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &category, resp, nil
@@ -142,7 +142,7 @@ func (s *CategoriesService) Get(ctx context.Context, categoryID string) (*Catego
 func (s *CategoriesService) List(ctx context.Context, options ListOptions) (*ListCategory, *jamf.Response, error) {
 	params, err := query.Values(options)
 	if err != nil {
-		return nil, nil, fmt.Errorf("query.Values(): %v", err)
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
 	}
 
 	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
@@ -154,23 +154,43 @@ func (s *CategoriesService) List(ctx context.Context, options ListOptions) (*Lis
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listCategory ListCategory
 	if err := json.Unmarshal(respBody, &listCategory); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &listCategory, resp, nil
 }
 
+// ListAll fetches all pages of the list.
+func (s *CategoriesService) ListAll(ctx context.Context, options ListAllOptions) ([]Category, error) {
+	return ListAll(ctx, s.listPage, options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *CategoriesService) ListPager(options ListOptions) *Pager[Category] {
+	return NewPager(s.listPage, options)
+}
+
+func (s *CategoriesService) listPage(ctx context.Context, options ListOptions) ([]Category, int, error) {
+	list, _, err := s.List(ctx, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, totalCount := pageResults(list.Categories, list.TotalCount)
+	return results, totalCount, nil
+}
+
 func (s *CategoriesService) Update(ctx context.Context, category *Category) (*Category, *jamf.Response, error) {
 	if category.ID == nil {
 		return nil, nil, errors.New("CategoriesService.Update(): cannot update category with nil ID")
@@ -184,7 +204,7 @@ func (s *CategoriesService) Update(ctx context.Context, category *Category) (*Ca
 
 	body, err := json.Marshal(category)
 	if err != nil {
-		return nil, nil, fmt.Errorf("json.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -196,18 +216,18 @@ func (s *CategoriesService) Update(ctx context.Context, category *Category) (*Ca
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, nil, fmt.Errorf("client.Put(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var newCategory Category
 	if err := json.Unmarshal(respBody, &newCategory); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &newCategory, resp, nil
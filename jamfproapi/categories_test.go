@@ -2,10 +2,12 @@ package jamfproapi
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
 )
 
 func TestCategoriesService_Create(t *testing.T) {
@@ -184,3 +186,44 @@ func TestCategoriesService_Update(t *testing.T) {
 		t.Fatalf("Categories.Update() returned %s, want %s", formatWithSpew(category), formatWithSpew(want))
 	}
 }
+
+func TestCategoriesService_Get_NotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	categoryID := "1"
+
+	mux.HandleFunc(buildHandlePath(categoriesPath, categoryID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"httpStatus": 404,
+			"errors": [
+				{
+					"code": "INVALID_ID",
+					"field": "id",
+					"description": "Category with id 1 does not exist",
+					"id": "1"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Categories.Get(ctx, categoryID)
+	if !jamf.IsNotFound(err) {
+		t.Fatalf("Categories.Get() returned %v, want not found error", err)
+	}
+
+	var errResp *jamf.ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Categories.Get() returned %v, want *jamf.ErrorResponse", err)
+	}
+	want := []jamf.ErrorDetail{
+		{Code: "INVALID_ID", Field: "id", Description: "Category with id 1 does not exist", ID: "1"},
+	}
+	if !cmp.Equal(errResp.Errors, want) {
+		t.Errorf("ErrorResponse.Errors is %s, want %s", formatWithSpew(errResp.Errors), formatWithSpew(want))
+	}
+}
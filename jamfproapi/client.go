@@ -16,11 +16,20 @@ type service struct {
 }
 
 type services struct {
-	APIAuthentication *APIAuthenticationService
-	Categories        *CategoriesService
-	Icon              *IconService
-	Scripts           *ScriptsService
-	SSOFailover       *SSOFailoverService
+	APIAuthentication                *APIAuthenticationService
+	AppInstallers                    *AppInstallersService
+	Categories                       *CategoriesService
+	ComputersInventory               *ComputersInventoryService
+	Icon                             *IconService
+	JCDS                             *JCDSService
+	ManagedSoftwareUpdates           *ManagedSoftwareUpdatesService
+	MDMCommands                      *MDMCommandsService
+	MobileDevices                    *MobileDevicesService
+	OAuth                            *OAuthService
+	Packages                         *PackagesService
+	PatchSoftwareTitleConfigurations *PatchSoftwareTitleConfigurationsService
+	Scripts                          *ScriptsService
+	SSOFailover                      *SSOFailoverService
 }
 
 type Client struct {
@@ -37,17 +46,59 @@ type ListOptions struct {
 	Filter   *string   `url:"filter,omitempty"`
 }
 
+// ObjectHistory is an entry of the history of an object.
+type ObjectHistory struct {
+	ID       *int    `json:"id,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Date     *string `json:"date,omitempty"`
+	Note     *string `json:"note,omitempty"`
+	Details  *string `json:"details,omitempty"`
+}
+
+type ListObjectHistory struct {
+	TotalCount *int             `json:"totalCount,omitempty"`
+	Histories  *[]ObjectHistory `json:"results,omitempty"`
+}
+
+type ObjectHistoryNote struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// ExportOptions selects the objects and the columns of an export.
+type ExportOptions struct {
+	Page     *int           `json:"page,omitempty"`
+	PageSize *int           `json:"pageSize,omitempty"`
+	Sort     *[]string      `json:"sort,omitempty"`
+	Filter   *string        `json:"filter,omitempty"`
+	Fields   *[]ExportField `json:"fields,omitempty"`
+}
+
+type ExportField struct {
+	FieldName          *string `json:"fieldName,omitempty"`
+	FieldLabelOverride *string `json:"fieldLabelOverride,omitempty"`
+}
+
 const apiEndpointPath = "/api"
 
-func NewClient(serverURL string) (*Client, error) {
+func NewClient(serverURL string, opts ...jamf.ClientOption) (*Client, error) {
 	u, err := url.Parse(serverURL)
 	if err != nil {
-		return nil, fmt.Errorf("url.Parse(): %v", err)
+		return nil, fmt.Errorf("url.Parse(): %w", err)
 	}
+
+	return newClient(u, opts...), nil
+}
+
+func newClient(serverURL *url.URL, opts ...jamf.ClientOption) *Client {
+	u := *serverURL
 	u.Path = path.Join(u.Path, apiEndpointPath)
 
-	client := jamf.NewBaseClient(u)
+	client := jamf.NewBaseClient(&u)
 	client.DefaultContentType = "application/json; charset=utf-8"
+	client.APIFamily = jamf.APIFamilyPro
+	for _, opt := range opts {
+		opt(client)
+	}
 
 	c := &Client{
 		BaseClient: client,
@@ -56,10 +107,19 @@ func NewClient(serverURL string) (*Client, error) {
 	c.common.client = c
 
 	c.APIAuthentication = (*APIAuthenticationService)(&c.common)
+	c.AppInstallers = (*AppInstallersService)(&c.common)
 	c.Categories = (*CategoriesService)(&c.common)
+	c.ComputersInventory = (*ComputersInventoryService)(&c.common)
 	c.Icon = (*IconService)(&c.common)
+	c.JCDS = (*JCDSService)(&c.common)
+	c.ManagedSoftwareUpdates = (*ManagedSoftwareUpdatesService)(&c.common)
+	c.MDMCommands = (*MDMCommandsService)(&c.common)
+	c.MobileDevices = (*MobileDevicesService)(&c.common)
+	c.OAuth = (*OAuthService)(&c.common)
+	c.Packages = (*PackagesService)(&c.common)
+	c.PatchSoftwareTitleConfigurations = (*PatchSoftwareTitleConfigurationsService)(&c.common)
 	c.Scripts = (*ScriptsService)(&c.common)
 	c.SSOFailover = (*SSOFailoverService)(&c.common)
 
-	return c, nil
+	return c
 }
@@ -2,7 +2,6 @@ package jamfproapi
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,9 +35,7 @@ func setup() (
 	server := httptest.NewServer(apiHandler)
 
 	client, _ = NewClient(server.URL)
-
-	authToken, _, _ := client.APIAuthentication.Token(context.Background(), "dummyUsername", "dummyPassword")
-	client.AuthorizationToken = authToken.Token
+	client.TokenSource, _ = NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
 
 	return client, mux, server.URL, server.Close
 }
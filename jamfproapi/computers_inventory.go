@@ -0,0 +1,722 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type ComputersInventoryService service
+
+// ComputerInventorySection is a section of the inventory of a computer.
+// Only the GENERAL section is returned when no section is selected.
+type ComputerInventorySection string
+
+const (
+	ComputerInventorySectionGeneral               ComputerInventorySection = "GENERAL"
+	ComputerInventorySectionDiskEncryption        ComputerInventorySection = "DISK_ENCRYPTION"
+	ComputerInventorySectionPurchasing            ComputerInventorySection = "PURCHASING"
+	ComputerInventorySectionApplications          ComputerInventorySection = "APPLICATIONS"
+	ComputerInventorySectionStorage               ComputerInventorySection = "STORAGE"
+	ComputerInventorySectionUserAndLocation       ComputerInventorySection = "USER_AND_LOCATION"
+	ComputerInventorySectionConfigurationProfiles ComputerInventorySection = "CONFIGURATION_PROFILES"
+	ComputerInventorySectionPrinters              ComputerInventorySection = "PRINTERS"
+	ComputerInventorySectionServices              ComputerInventorySection = "SERVICES"
+	ComputerInventorySectionHardware              ComputerInventorySection = "HARDWARE"
+	ComputerInventorySectionLocalUserAccounts     ComputerInventorySection = "LOCAL_USER_ACCOUNTS"
+	ComputerInventorySectionCertificates          ComputerInventorySection = "CERTIFICATES"
+	ComputerInventorySectionAttachments           ComputerInventorySection = "ATTACHMENTS"
+	ComputerInventorySectionPlugins               ComputerInventorySection = "PLUGINS"
+	ComputerInventorySectionPackageReceipts       ComputerInventorySection = "PACKAGE_RECEIPTS"
+	ComputerInventorySectionFonts                 ComputerInventorySection = "FONTS"
+	ComputerInventorySectionSecurity              ComputerInventorySection = "SECURITY"
+	ComputerInventorySectionOperatingSystem       ComputerInventorySection = "OPERATING_SYSTEM"
+	ComputerInventorySectionLicensedSoftware      ComputerInventorySection = "LICENSED_SOFTWARE"
+	ComputerInventorySectionIBeacons              ComputerInventorySection = "IBEACONS"
+	ComputerInventorySectionSoftwareUpdates       ComputerInventorySection = "SOFTWARE_UPDATES"
+	ComputerInventorySectionExtensionAttributes   ComputerInventorySection = "EXTENSION_ATTRIBUTES"
+	ComputerInventorySectionContentCaching        ComputerInventorySection = "CONTENT_CACHING"
+	ComputerInventorySectionGroupMemberships      ComputerInventorySection = "GROUP_MEMBERSHIPS"
+)
+
+type ComputerInventory struct {
+	ID                    *string                                  `json:"id,omitempty"`
+	UDID                  *string                                  `json:"udid,omitempty"`
+	General               *ComputerInventoryGeneral                `json:"general,omitempty"`
+	DiskEncryption        *ComputerInventoryDiskEncryption         `json:"diskEncryption,omitempty"`
+	Purchasing            *ComputerInventoryPurchasing             `json:"purchasing,omitempty"`
+	Applications          *[]ComputerInventoryApplication          `json:"applications,omitempty"`
+	Storage               *ComputerInventoryStorage                `json:"storage,omitempty"`
+	UserAndLocation       *ComputerInventoryUserAndLocation        `json:"userAndLocation,omitempty"`
+	ConfigurationProfiles *[]ComputerInventoryConfigurationProfile `json:"configurationProfiles,omitempty"`
+	Printers              *[]ComputerInventoryPrinter              `json:"printers,omitempty"`
+	Services              *[]ComputerInventoryService              `json:"services,omitempty"`
+	Hardware              *ComputerInventoryHardware               `json:"hardware,omitempty"`
+	LocalUserAccounts     *[]ComputerInventoryLocalUserAccount     `json:"localUserAccounts,omitempty"`
+	Certificates          *[]ComputerInventoryCertificate          `json:"certificates,omitempty"`
+	Attachments           *[]ComputerInventoryAttachment           `json:"attachments,omitempty"`
+	Plugins               *[]ComputerInventorySoftware             `json:"plugins,omitempty"`
+	PackageReceipts       *ComputerInventoryPackageReceipts        `json:"packageReceipts,omitempty"`
+	Fonts                 *[]ComputerInventorySoftware             `json:"fonts,omitempty"`
+	Security              *ComputerInventorySecurity               `json:"security,omitempty"`
+	OperatingSystem       *ComputerInventoryOperatingSystem        `json:"operatingSystem,omitempty"`
+	LicensedSoftware      *[]ComputerInventoryLicensedSoftware     `json:"licensedSoftware,omitempty"`
+	IBeacons              *[]ComputerInventoryIBeacon              `json:"ibeacons,omitempty"`
+	SoftwareUpdates       *[]ComputerInventorySoftwareUpdate       `json:"softwareUpdates,omitempty"`
+	ExtensionAttributes   *[]ComputerInventoryExtensionAttribute   `json:"extensionAttributes,omitempty"`
+	ContentCaching        *ComputerInventoryContentCaching         `json:"contentCaching,omitempty"`
+	GroupMemberships      *[]ComputerInventoryGroupMembership      `json:"groupMemberships,omitempty"`
+}
+
+// ComputerInventoryUpdate is the sections of the inventory of a computer which can be updated.
+// The fields which are not set are not changed.
+type ComputerInventoryUpdate struct {
+	General             *ComputerInventoryGeneral              `json:"general,omitempty"`
+	Purchasing          *ComputerInventoryPurchasing           `json:"purchasing,omitempty"`
+	UserAndLocation     *ComputerInventoryUserAndLocation      `json:"userAndLocation,omitempty"`
+	Hardware            *ComputerInventoryHardware             `json:"hardware,omitempty"`
+	OperatingSystem     *ComputerInventoryOperatingSystem      `json:"operatingSystem,omitempty"`
+	ExtensionAttributes *[]ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryGeneral struct {
+	Name                                 *string                                   `json:"name,omitempty"`
+	LastIPAddress                        *string                                   `json:"lastIpAddress,omitempty"`
+	LastReportedIP                       *string                                   `json:"lastReportedIp,omitempty"`
+	JamfBinaryVersion                    *string                                   `json:"jamfBinaryVersion,omitempty"`
+	Platform                             *string                                   `json:"platform,omitempty"`
+	Barcode1                             *string                                   `json:"barcode1,omitempty"`
+	Barcode2                             *string                                   `json:"barcode2,omitempty"`
+	AssetTag                             *string                                   `json:"assetTag,omitempty"`
+	RemoteManagement                     *ComputerInventoryGeneralRemoteManagement `json:"remoteManagement,omitempty"`
+	Supervised                           *bool                                     `json:"supervised,omitempty"`
+	MDMCapable                           *ComputerInventoryGeneralMDMCapable       `json:"mdmCapable,omitempty"`
+	ReportDate                           *string                                   `json:"reportDate,omitempty"`
+	LastContactTime                      *string                                   `json:"lastContactTime,omitempty"`
+	LastCloudBackupDate                  *string                                   `json:"lastCloudBackupDate,omitempty"`
+	LastEnrolledDate                     *string                                   `json:"lastEnrolledDate,omitempty"`
+	MDMProfileExpiration                 *string                                   `json:"mdmProfileExpiration,omitempty"`
+	InitialEntryDate                     *string                                   `json:"initialEntryDate,omitempty"`
+	DistributionPoint                    *string                                   `json:"distributionPoint,omitempty"`
+	EnrollmentMethod                     *ComputerInventoryGeneralEnrollmentMethod `json:"enrollmentMethod,omitempty"`
+	Site                                 *ComputerInventorySite                    `json:"site,omitempty"`
+	ITunesStoreAccountActive             *bool                                     `json:"itunesStoreAccountActive,omitempty"`
+	EnrolledViaAutomatedDeviceEnrollment *bool                                     `json:"enrolledViaAutomatedDeviceEnrollment,omitempty"`
+	UserApprovedMDM                      *bool                                     `json:"userApprovedMdm,omitempty"`
+	DeclarativeDeviceManagementEnabled   *bool                                     `json:"declarativeDeviceManagementEnabled,omitempty"`
+	ManagementID                         *string                                   `json:"managementId,omitempty"`
+	ExtensionAttributes                  *[]ComputerInventoryExtensionAttribute    `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryGeneralRemoteManagement struct {
+	Managed            *bool   `json:"managed,omitempty"`
+	ManagementUsername *string `json:"managementUsername,omitempty"`
+}
+
+type ComputerInventoryGeneralMDMCapable struct {
+	Capable      *bool     `json:"capable,omitempty"`
+	CapableUsers *[]string `json:"capableUsers,omitempty"`
+}
+
+type ComputerInventoryGeneralEnrollmentMethod struct {
+	ID         *string `json:"id,omitempty"`
+	ObjectName *string `json:"objectName,omitempty"`
+	ObjectType *string `json:"objectType,omitempty"`
+}
+
+type ComputerInventorySite struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type ComputerInventoryDiskEncryption struct {
+	BootPartitionEncryptionDetails      *ComputerInventoryPartitionEncryption `json:"bootPartitionEncryptionDetails,omitempty"`
+	IndividualRecoveryKeyValidityStatus *string                               `json:"individualRecoveryKeyValidityStatus,omitempty"`
+	InstitutionalRecoveryKeyPresent     *bool                                 `json:"institutionalRecoveryKeyPresent,omitempty"`
+	DiskEncryptionConfigurationName     *string                               `json:"diskEncryptionConfigurationName,omitempty"`
+	FileVault2EnabledUserNames          *[]string                             `json:"fileVault2EnabledUserNames,omitempty"`
+	FileVault2EligibilityMessage        *string                               `json:"fileVault2EligibilityMessage,omitempty"`
+}
+
+type ComputerInventoryPartitionEncryption struct {
+	PartitionName              *string `json:"partitionName,omitempty"`
+	PartitionFileVault2State   *string `json:"partitionFileVault2State,omitempty"`
+	PartitionFileVault2Percent *int    `json:"partitionFileVault2Percent,omitempty"`
+}
+
+type ComputerInventoryPurchasing struct {
+	Leased              *bool                                  `json:"leased,omitempty"`
+	Purchased           *bool                                  `json:"purchased,omitempty"`
+	PONumber            *string                                `json:"poNumber,omitempty"`
+	PODate              *string                                `json:"poDate,omitempty"`
+	Vendor              *string                                `json:"vendor,omitempty"`
+	WarrantyDate        *string                                `json:"warrantyDate,omitempty"`
+	AppleCareID         *string                                `json:"appleCareId,omitempty"`
+	LeaseDate           *string                                `json:"leaseDate,omitempty"`
+	PurchasePrice       *string                                `json:"purchasePrice,omitempty"`
+	LifeExpectancy      *int                                   `json:"lifeExpectancy,omitempty"`
+	PurchasingAccount   *string                                `json:"purchasingAccount,omitempty"`
+	PurchasingContact   *string                                `json:"purchasingContact,omitempty"`
+	ExtensionAttributes *[]ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryApplication struct {
+	Name              *string `json:"name,omitempty"`
+	Path              *string `json:"path,omitempty"`
+	Version           *string `json:"version,omitempty"`
+	MacAppStore       *bool   `json:"macAppStore,omitempty"`
+	SizeMegabytes     *int    `json:"sizeMegabytes,omitempty"`
+	BundleID          *string `json:"bundleId,omitempty"`
+	UpdateAvailable   *bool   `json:"updateAvailable,omitempty"`
+	ExternalVersionID *string `json:"externalVersionId,omitempty"`
+}
+
+type ComputerInventoryStorage struct {
+	BootDriveAvailableSpaceMegabytes *int                            `json:"bootDriveAvailableSpaceMegabytes,omitempty"`
+	Disks                            *[]ComputerInventoryStorageDisk `json:"disks,omitempty"`
+}
+
+type ComputerInventoryStorageDisk struct {
+	ID            *string                                  `json:"id,omitempty"`
+	Device        *string                                  `json:"device,omitempty"`
+	Model         *string                                  `json:"model,omitempty"`
+	Revision      *string                                  `json:"revision,omitempty"`
+	SerialNumber  *string                                  `json:"serialNumber,omitempty"`
+	SizeMegabytes *int                                     `json:"sizeMegabytes,omitempty"`
+	SMARTStatus   *string                                  `json:"smartStatus,omitempty"`
+	Type          *string                                  `json:"type,omitempty"`
+	Partitions    *[]ComputerInventoryStorageDiskPartition `json:"partitions,omitempty"`
+}
+
+type ComputerInventoryStorageDiskPartition struct {
+	Name                      *string `json:"name,omitempty"`
+	SizeMegabytes             *int    `json:"sizeMegabytes,omitempty"`
+	AvailableMegabytes        *int    `json:"availableMegabytes,omitempty"`
+	PartitionType             *string `json:"partitionType,omitempty"`
+	PercentUsed               *int    `json:"percentUsed,omitempty"`
+	FileVault2State           *string `json:"fileVault2State,omitempty"`
+	FileVault2ProgressPercent *int    `json:"fileVault2ProgressPercent,omitempty"`
+	LVMManaged                *bool   `json:"lvmManaged,omitempty"`
+}
+
+type ComputerInventoryUserAndLocation struct {
+	Username            *string                                `json:"username,omitempty"`
+	Realname            *string                                `json:"realname,omitempty"`
+	Email               *string                                `json:"email,omitempty"`
+	Position            *string                                `json:"position,omitempty"`
+	Phone               *string                                `json:"phone,omitempty"`
+	DepartmentID        *string                                `json:"departmentId,omitempty"`
+	BuildingID          *string                                `json:"buildingId,omitempty"`
+	Room                *string                                `json:"room,omitempty"`
+	ExtensionAttributes *[]ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryConfigurationProfile struct {
+	ID                *string `json:"id,omitempty"`
+	Username          *string `json:"username,omitempty"`
+	LastInstalled     *string `json:"lastInstalled,omitempty"`
+	Removable         *bool   `json:"removable,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	ProfileIdentifier *string `json:"profileIdentifier,omitempty"`
+}
+
+type ComputerInventoryPrinter struct {
+	Name     *string `json:"name,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	URI      *string `json:"uri,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+type ComputerInventoryService struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type ComputerInventoryHardware struct {
+	Make                   *string                                `json:"make,omitempty"`
+	Model                  *string                                `json:"model,omitempty"`
+	ModelIdentifier        *string                                `json:"modelIdentifier,omitempty"`
+	SerialNumber           *string                                `json:"serialNumber,omitempty"`
+	ProcessorSpeedMhz      *int                                   `json:"processorSpeedMhz,omitempty"`
+	ProcessorCount         *int                                   `json:"processorCount,omitempty"`
+	CoreCount              *int                                   `json:"coreCount,omitempty"`
+	ProcessorType          *string                                `json:"processorType,omitempty"`
+	ProcessorArchitecture  *string                                `json:"processorArchitecture,omitempty"`
+	BusSpeedMhz            *int                                   `json:"busSpeedMhz,omitempty"`
+	CacheSizeKilobytes     *int                                   `json:"cacheSizeKilobytes,omitempty"`
+	NetworkAdapterType     *string                                `json:"networkAdapterType,omitempty"`
+	MacAddress             *string                                `json:"macAddress,omitempty"`
+	AltNetworkAdapterType  *string                                `json:"altNetworkAdapterType,omitempty"`
+	AltMacAddress          *string                                `json:"altMacAddress,omitempty"`
+	TotalRAMMegabytes      *int                                   `json:"totalRamMegabytes,omitempty"`
+	OpenRAMSlots           *int                                   `json:"openRamSlots,omitempty"`
+	BatteryCapacityPercent *int                                   `json:"batteryCapacityPercent,omitempty"`
+	SMCVersion             *string                                `json:"smcVersion,omitempty"`
+	NICSpeed               *string                                `json:"nicSpeed,omitempty"`
+	OpticalDrive           *string                                `json:"opticalDrive,omitempty"`
+	BootROM                *string                                `json:"bootRom,omitempty"`
+	BLECapable             *bool                                  `json:"bleCapable,omitempty"`
+	SupportsIOSAppInstalls *bool                                  `json:"supportsIosAppInstalls,omitempty"`
+	AppleSilicon           *bool                                  `json:"appleSilicon,omitempty"`
+	ExtensionAttributes    *[]ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryLocalUserAccount struct {
+	UID                            *string `json:"uid,omitempty"`
+	UserGUID                       *string `json:"userGuid,omitempty"`
+	Username                       *string `json:"username,omitempty"`
+	FullName                       *string `json:"fullName,omitempty"`
+	Admin                          *bool   `json:"admin,omitempty"`
+	HomeDirectory                  *string `json:"homeDirectory,omitempty"`
+	HomeDirectorySizeMb            *int    `json:"homeDirectorySizeMb,omitempty"`
+	FileVault2Enabled              *bool   `json:"fileVault2Enabled,omitempty"`
+	UserAccountType                *string `json:"userAccountType,omitempty"`
+	PasswordMinLength              *int    `json:"passwordMinLength,omitempty"`
+	PasswordMaxAge                 *int    `json:"passwordMaxAge,omitempty"`
+	PasswordMinComplexCharacters   *int    `json:"passwordMinComplexCharacters,omitempty"`
+	PasswordHistoryDepth           *int    `json:"passwordHistoryDepth,omitempty"`
+	PasswordRequireAlphanumeric    *bool   `json:"passwordRequireAlphanumeric,omitempty"`
+	ComputerAzureActiveDirectoryID *string `json:"computerAzureActiveDirectoryId,omitempty"`
+	UserAzureActiveDirectoryID     *string `json:"userAzureActiveDirectoryId,omitempty"`
+	AzureActiveDirectoryID         *string `json:"azureActiveDirectoryId,omitempty"`
+}
+
+type ComputerInventoryCertificate struct {
+	CommonName        *string `json:"commonName,omitempty"`
+	Identity          *bool   `json:"identity,omitempty"`
+	ExpirationDate    *string `json:"expirationDate,omitempty"`
+	Username          *string `json:"username,omitempty"`
+	LifecycleStatus   *string `json:"lifecycleStatus,omitempty"`
+	CertificateStatus *string `json:"certificateStatus,omitempty"`
+	SubjectName       *string `json:"subjectName,omitempty"`
+	SerialNumber      *string `json:"serialNumber,omitempty"`
+	SHA1Fingerprint   *string `json:"sha1Fingerprint,omitempty"`
+	IssuedDate        *string `json:"issuedDate,omitempty"`
+}
+
+type ComputerInventoryAttachment struct {
+	ID        *string `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	FileType  *string `json:"fileType,omitempty"`
+	SizeBytes *int64  `json:"sizeBytes,omitempty"`
+}
+
+// ComputerInventorySoftware is a plugin or a font of a computer.
+type ComputerInventorySoftware struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	Path    *string `json:"path,omitempty"`
+}
+
+type ComputerInventoryPackageReceipts struct {
+	InstalledByJamfPro      *[]string `json:"installedByJamfPro,omitempty"`
+	InstalledByInstallerSWU *[]string `json:"installedByInstallerSwu,omitempty"`
+	Cached                  *[]string `json:"cached,omitempty"`
+}
+
+type ComputerInventorySecurity struct {
+	SIPStatus             *string `json:"sipStatus,omitempty"`
+	GatekeeperStatus      *string `json:"gatekeeperStatus,omitempty"`
+	XProtectVersion       *string `json:"xprotectVersion,omitempty"`
+	AutoLoginDisabled     *bool   `json:"autoLoginDisabled,omitempty"`
+	RemoteDesktopEnabled  *bool   `json:"remoteDesktopEnabled,omitempty"`
+	ActivationLockEnabled *bool   `json:"activationLockEnabled,omitempty"`
+	RecoveryLockEnabled   *bool   `json:"recoveryLockEnabled,omitempty"`
+	FirewallEnabled       *bool   `json:"firewallEnabled,omitempty"`
+	SecureBootLevel       *string `json:"secureBootLevel,omitempty"`
+	ExternalBootLevel     *string `json:"externalBootLevel,omitempty"`
+	BootstrapTokenAllowed *bool   `json:"bootstrapTokenAllowed,omitempty"`
+}
+
+type ComputerInventoryOperatingSystem struct {
+	Name                     *string                                `json:"name,omitempty"`
+	Version                  *string                                `json:"version,omitempty"`
+	Build                    *string                                `json:"build,omitempty"`
+	SupplementalBuildVersion *string                                `json:"supplementalBuildVersion,omitempty"`
+	RapidSecurityResponse    *string                                `json:"rapidSecurityResponse,omitempty"`
+	ActiveDirectoryStatus    *string                                `json:"activeDirectoryStatus,omitempty"`
+	FileVault2Status         *string                                `json:"fileVault2Status,omitempty"`
+	SoftwareUpdateDeviceID   *string                                `json:"softwareUpdateDeviceId,omitempty"`
+	ExtensionAttributes      *[]ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryLicensedSoftware struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type ComputerInventoryIBeacon struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type ComputerInventorySoftwareUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Version     *string `json:"version,omitempty"`
+	PackageName *string `json:"packageName,omitempty"`
+}
+
+// ComputerInventoryExtensionAttribute is the value of an extension attribute of a computer.
+// Only DefinitionID and Values are required to update it.
+type ComputerInventoryExtensionAttribute struct {
+	DefinitionID *string   `json:"definitionId,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Enabled      *bool     `json:"enabled,omitempty"`
+	MultiValue   *bool     `json:"multiValue,omitempty"`
+	Values       *[]string `json:"values,omitempty"`
+	DataType     *string   `json:"dataType,omitempty"`
+	Options      *[]string `json:"options,omitempty"`
+	InputType    *string   `json:"inputType,omitempty"`
+}
+
+type ComputerInventoryContentCaching struct {
+	ComputerContentCachingInformationID *string `json:"computerContentCachingInformationId,omitempty"`
+	Activated                           *bool   `json:"activated,omitempty"`
+	Active                              *bool   `json:"active,omitempty"`
+	ActualCacheBytesUsed                *int64  `json:"actualCacheBytesUsed,omitempty"`
+	CacheBytesFree                      *int64  `json:"cacheBytesFree,omitempty"`
+	CacheBytesLimit                     *int64  `json:"cacheBytesLimit,omitempty"`
+	CacheStatus                         *string `json:"cacheStatus,omitempty"`
+	CacheBytesUsed                      *int64  `json:"cacheBytesUsed,omitempty"`
+	DataMigrationCompleted              *bool   `json:"dataMigrationCompleted,omitempty"`
+	DataMigrationProgressPercentage     *int    `json:"dataMigrationProgressPercentage,omitempty"`
+	MaxCachePressureLast1HourPercentage *int    `json:"maxCachePressureLast1HourPercentage,omitempty"`
+	PersonalCacheBytesFree              *int64  `json:"personalCacheBytesFree,omitempty"`
+	PersonalCacheBytesLimit             *int64  `json:"personalCacheBytesLimit,omitempty"`
+	PersonalCacheBytesUsed              *int64  `json:"personalCacheBytesUsed,omitempty"`
+	Port                                *int    `json:"port,omitempty"`
+	PublicAddress                       *string `json:"publicAddress,omitempty"`
+	RegistrationError                   *string `json:"registrationError,omitempty"`
+	RegistrationResponseCode            *int    `json:"registrationResponseCode,omitempty"`
+	RegistrationStarted                 *string `json:"registrationStarted,omitempty"`
+	RegistrationStatus                  *string `json:"registrationStatus,omitempty"`
+	RestrictedMedia                     *bool   `json:"restrictedMedia,omitempty"`
+	ServerGUID                          *string `json:"serverGuid,omitempty"`
+	StartupStatus                       *string `json:"startupStatus,omitempty"`
+	TetheratorStatus                    *string `json:"tetheratorStatus,omitempty"`
+	TotalBytesAreSince                  *string `json:"totalBytesAreSince,omitempty"`
+	TotalBytesDropped                   *int64  `json:"totalBytesDropped,omitempty"`
+	TotalBytesImported                  *int64  `json:"totalBytesImported,omitempty"`
+	TotalBytesReturnedToChildren        *int64  `json:"totalBytesReturnedToChildren,omitempty"`
+	TotalBytesReturnedToClients         *int64  `json:"totalBytesReturnedToClients,omitempty"`
+	TotalBytesReturnedToPeers           *int64  `json:"totalBytesReturnedToPeers,omitempty"`
+	TotalBytesStoredFromOrigin          *int64  `json:"totalBytesStoredFromOrigin,omitempty"`
+	TotalBytesStoredFromParents         *int64  `json:"totalBytesStoredFromParents,omitempty"`
+	TotalBytesStoredFromPeers           *int64  `json:"totalBytesStoredFromPeers,omitempty"`
+}
+
+type ComputerInventoryGroupMembership struct {
+	GroupID    *string `json:"groupId,omitempty"`
+	GroupName  *string `json:"groupName,omitempty"`
+	SmartGroup *bool   `json:"smartGroup,omitempty"`
+}
+
+type ListComputerInventory struct {
+	TotalCount *int                 `json:"totalCount,omitempty"`
+	Computers  *[]ComputerInventory `json:"results,omitempty"`
+}
+
+// ComputerInventoryFileVault is the FileVault information of a computer including its personal recovery key.
+type ComputerInventoryFileVault struct {
+	ComputerID                          *string                               `json:"computerId,omitempty"`
+	Name                                *string                               `json:"name,omitempty"`
+	PersonalRecoveryKey                 *string                               `json:"personalRecoveryKey,omitempty"`
+	BootPartitionEncryptionDetails      *ComputerInventoryPartitionEncryption `json:"bootPartitionEncryptionDetails,omitempty"`
+	IndividualRecoveryKeyValidityStatus *string                               `json:"individualRecoveryKeyValidityStatus,omitempty"`
+	InstitutionalRecoveryKeyPresent     *bool                                 `json:"institutionalRecoveryKeyPresent,omitempty"`
+	DiskEncryptionConfigurationName     *string                               `json:"diskEncryptionConfigurationName,omitempty"`
+}
+
+type ListComputerInventoryFileVault struct {
+	TotalCount *int                          `json:"totalCount,omitempty"`
+	FileVaults *[]ComputerInventoryFileVault `json:"results,omitempty"`
+}
+
+// ComputerInventoryMDMCommand is the MDM command sent to a computer.
+type ComputerInventoryMDMCommand struct {
+	DeviceID    *string `json:"deviceId,omitempty"`
+	CommandUUID *string `json:"commandUuid,omitempty"`
+}
+
+const (
+	computersInventoryPath       = "/v1/computers-inventory"
+	computersInventoryDetailPath = "/v1/computers-inventory-detail"
+	computerInventoryPath        = "/v1/computer-inventory"
+)
+
+func (s *ComputersInventoryService) Delete(ctx context.Context, computerID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(computersInventoryPath, computerID),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// Erase sends the command to erase a computer. The pin is required to unlock the computer after it is erased, e.g. for an Intel Mac.
+func (s *ComputersInventoryService) Erase(ctx context.Context, computerID string, pin *string) (*jamf.Response, error) {
+	var data struct {
+		Pin *string `json:"pin,omitempty"`
+	}
+	data.Pin = pin
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(computerInventoryPath, computerID, "erase"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// Get returns the sections of the inventory of a computer.
+func (s *ComputersInventoryService) Get(ctx context.Context, computerID string, sections ...ComputerInventorySection) (*ComputerInventory, *jamf.Response, error) {
+	return s.get(ctx, jamf.Uri{
+		Entity: path.Join(computersInventoryPath, computerID),
+		Params: sectionParams(url.Values{}, sections),
+	})
+}
+
+// GetDetail returns all the sections of the inventory of a computer.
+func (s *ComputersInventoryService) GetDetail(ctx context.Context, computerID string) (*ComputerInventory, *jamf.Response, error) {
+	return s.get(ctx, jamf.Uri{
+		Entity: path.Join(computersInventoryDetailPath, computerID),
+	})
+}
+
+func (s *ComputersInventoryService) get(ctx context.Context, uri jamf.Uri) (*ComputerInventory, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri:              uri,
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var computer ComputerInventory
+	if err := json.Unmarshal(respBody, &computer); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &computer, resp, nil
+}
+
+// GetFileVault returns the FileVault information of a computer including its personal recovery key.
+func (s *ComputersInventoryService) GetFileVault(ctx context.Context, computerID string) (*ComputerInventoryFileVault, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(computersInventoryPath, computerID, "filevault"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var fileVault ComputerInventoryFileVault
+	if err := json.Unmarshal(respBody, &fileVault); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &fileVault, resp, nil
+}
+
+// List returns a page of the computers with the sections of their inventory.
+func (s *ComputersInventoryService) List(ctx context.Context, options ListOptions, sections ...ComputerInventorySection) (*ListComputerInventory, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: computersInventoryPath,
+			Params: sectionParams(params, sections),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listComputerInventory ListComputerInventory
+	if err := json.Unmarshal(respBody, &listComputerInventory); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listComputerInventory, resp, nil
+}
+
+// ListAll fetches all pages of the list.
+func (s *ComputersInventoryService) ListAll(ctx context.Context, options ListAllOptions, sections ...ComputerInventorySection) ([]ComputerInventory, error) {
+	return ListAll(ctx, s.listPage(sections), options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *ComputersInventoryService) ListPager(options ListOptions, sections ...ComputerInventorySection) *Pager[ComputerInventory] {
+	return NewPager(s.listPage(sections), options)
+}
+
+func (s *ComputersInventoryService) listPage(sections []ComputerInventorySection) ListPageFunc[ComputerInventory] {
+	return func(ctx context.Context, options ListOptions) ([]ComputerInventory, int, error) {
+		list, _, err := s.List(ctx, options, sections...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Computers, list.TotalCount)
+		return results, totalCount, nil
+	}
+}
+
+// ListFileVault returns a page of the FileVault information of the computers.
+func (s *ComputersInventoryService) ListFileVault(ctx context.Context, options ListOptions) (*ListComputerInventoryFileVault, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(computersInventoryPath, "filevault"),
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listFileVault ListComputerInventoryFileVault
+	if err := json.Unmarshal(respBody, &listFileVault); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listFileVault, resp, nil
+}
+
+// RemoveMDMProfile sends the command to remove the MDM profile from a computer.
+func (s *ComputersInventoryService) RemoveMDMProfile(ctx context.Context, computerID string) (*ComputerInventoryMDMCommand, *jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(computerInventoryPath, computerID, "remove-mdm-profile"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var command ComputerInventoryMDMCommand
+	if err := json.Unmarshal(respBody, &command); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &command, resp, nil
+}
+
+// Update updates the inventory of a computer with PATCH, so only the fields which are set are changed.
+func (s *ComputersInventoryService) Update(ctx context.Context, computerID string, computer *ComputerInventoryUpdate) (*ComputerInventory, *jamf.Response, error) {
+	if computer == nil {
+		return nil, nil, errors.New("ComputersInventoryService.Update(): cannot update nil computer")
+	}
+
+	body, err := json.Marshal(computer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Patch(ctx, jamf.PatchHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(computersInventoryDetailPath, computerID),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Patch(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newComputer ComputerInventory
+	if err := json.Unmarshal(respBody, &newComputer); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newComputer, resp, nil
+}
+
+// sectionParams adds the sections to the query parameters, e.g. section=GENERAL&section=HARDWARE.
+func sectionParams[T ~string](params url.Values, sections []T) url.Values {
+	for _, section := range sections {
+		params.Add("section", string(section))
+	}
+	return params
+}
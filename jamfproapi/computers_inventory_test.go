@@ -0,0 +1,319 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputersInventoryService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computersInventoryPath, computerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.ComputersInventory.Delete(ctx, computerID)
+	if err != nil {
+		t.Fatalf("ComputersInventory.Delete(): %v", err)
+	}
+}
+
+func TestComputersInventoryService_Erase(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computerInventoryPath, computerID, "erase"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"pin":"123456"}`))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.ComputersInventory.Erase(ctx, computerID, ptr("123456"))
+	if err != nil {
+		t.Fatalf("ComputersInventory.Erase(): %v", err)
+	}
+}
+
+func TestComputersInventoryService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computersInventoryPath, computerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query()["section"], []string{"GENERAL", "HARDWARE"}; !cmp.Equal(got, want) {
+			t.Errorf("Query()[section] returned %v, want %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"udid": "123",
+			"general": {
+				"name": "Boalime",
+				"site": {
+					"id": "-1",
+					"name": "None"
+				}
+			},
+			"hardware": {
+				"serialNumber": "5c28fdae",
+				"appleSilicon": true
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.ComputersInventory.Get(ctx, computerID, ComputerInventorySectionGeneral, ComputerInventorySectionHardware)
+	if err != nil {
+		t.Fatalf("ComputersInventory.Get(): %v", err)
+	}
+
+	want := &ComputerInventory{
+		ID:   ptr("1"),
+		UDID: ptr("123"),
+		General: &ComputerInventoryGeneral{
+			Name: ptr("Boalime"),
+			Site: &ComputerInventorySite{
+				ID:   ptr("-1"),
+				Name: ptr("None"),
+			},
+		},
+		Hardware: &ComputerInventoryHardware{
+			SerialNumber: ptr("5c28fdae"),
+			AppleSilicon: ptr(true),
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("ComputersInventory.Get() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
+
+func TestComputersInventoryService_GetDetail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computersInventoryDetailPath, computerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"security": {
+				"sipStatus": "ENABLED",
+				"firewallEnabled": true
+			},
+			"extensionAttributes": [
+				{
+					"definitionId": "23",
+					"name": "Some Attribute",
+					"values": ["some value"]
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.ComputersInventory.GetDetail(ctx, computerID)
+	if err != nil {
+		t.Fatalf("ComputersInventory.GetDetail(): %v", err)
+	}
+
+	want := &ComputerInventory{
+		ID: ptr("1"),
+		Security: &ComputerInventorySecurity{
+			SIPStatus:       ptr("ENABLED"),
+			FirewallEnabled: ptr(true),
+		},
+		ExtensionAttributes: &[]ComputerInventoryExtensionAttribute{
+			{
+				DefinitionID: ptr("23"),
+				Name:         ptr("Some Attribute"),
+				Values:       &[]string{"some value"},
+			},
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("ComputersInventory.GetDetail() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
+
+func TestComputersInventoryService_GetFileVault(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computersInventoryPath, computerID, "filevault"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"computerId": "1",
+			"name": "Boalime",
+			"personalRecoveryKey": "xxxx-xxxx-xxxx",
+			"individualRecoveryKeyValidityStatus": "VALID",
+			"institutionalRecoveryKeyPresent": false
+		}`)))
+	})
+
+	ctx := context.Background()
+	fileVault, _, err := client.ComputersInventory.GetFileVault(ctx, computerID)
+	if err != nil {
+		t.Fatalf("ComputersInventory.GetFileVault(): %v", err)
+	}
+
+	want := &ComputerInventoryFileVault{
+		ComputerID:                          ptr("1"),
+		Name:                                ptr("Boalime"),
+		PersonalRecoveryKey:                 ptr("xxxx-xxxx-xxxx"),
+		IndividualRecoveryKeyValidityStatus: ptr("VALID"),
+		InstitutionalRecoveryKeyPresent:     ptr(false),
+	}
+	if !cmp.Equal(fileVault, want) {
+		t.Errorf("ComputersInventory.GetFileVault() returned %s, want %s", formatWithSpew(fileVault), formatWithSpew(want))
+	}
+}
+
+func TestComputersInventoryService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(computersInventoryPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `general.name=="Boalime"`; got != want {
+			t.Errorf("Query().Get(filter) returned %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query()["section"], []string{"GENERAL"}; !cmp.Equal(got, want) {
+			t.Errorf("Query()[section] returned %v, want %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": "1",
+					"general": {
+						"name": "Boalime"
+					}
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	list, _, err := client.ComputersInventory.List(ctx, ListOptions{Filter: ptr(`general.name=="Boalime"`)}, ComputerInventorySectionGeneral)
+	if err != nil {
+		t.Fatalf("ComputersInventory.List(): %v", err)
+	}
+
+	wantComputers := &[]ComputerInventory{
+		{
+			ID: ptr("1"),
+			General: &ComputerInventoryGeneral{
+				Name: ptr("Boalime"),
+			},
+		},
+	}
+	if !cmp.Equal(list.Computers, wantComputers) {
+		t.Errorf("ComputersInventory.List() returned %s, want %s", formatWithSpew(list.Computers), formatWithSpew(wantComputers))
+	}
+
+	if wantTotalCount := 1; *list.TotalCount != wantTotalCount {
+		t.Errorf("ComputersInventory.List() returned %s, want %s", formatWithSpew(list.TotalCount), formatWithSpew(wantTotalCount))
+	}
+}
+
+func TestComputersInventoryService_RemoveMDMProfile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computerInventoryPath, computerID, "remove-mdm-profile"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"deviceId": "1",
+			"commandUuid": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"
+		}`)))
+	})
+
+	ctx := context.Background()
+	command, _, err := client.ComputersInventory.RemoveMDMProfile(ctx, computerID)
+	if err != nil {
+		t.Fatalf("ComputersInventory.RemoveMDMProfile(): %v", err)
+	}
+
+	want := &ComputerInventoryMDMCommand{
+		DeviceID:    ptr("1"),
+		CommandUUID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+	}
+	if !cmp.Equal(command, want) {
+		t.Errorf("ComputersInventory.RemoveMDMProfile() returned %s, want %s", formatWithSpew(command), formatWithSpew(want))
+	}
+}
+
+func TestComputersInventoryService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	computerID := "1"
+
+	mux.HandleFunc(buildHandlePath(computersInventoryDetailPath, computerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, []byte(`{"general":{"assetTag":"A-1"},"extensionAttributes":[{"definitionId":"23","values":["new value"]}]}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"general": {
+				"assetTag": "A-1"
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	computer, _, err := client.ComputersInventory.Update(ctx, computerID, &ComputerInventoryUpdate{
+		General: &ComputerInventoryGeneral{
+			AssetTag: ptr("A-1"),
+		},
+		ExtensionAttributes: &[]ComputerInventoryExtensionAttribute{
+			{
+				DefinitionID: ptr("23"),
+				Values:       &[]string{"new value"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ComputersInventory.Update(): %v", err)
+	}
+
+	want := &ComputerInventory{
+		ID: ptr("1"),
+		General: &ComputerInventoryGeneral{
+			AssetTag: ptr("A-1"),
+		},
+	}
+	if !cmp.Equal(computer, want) {
+		t.Errorf("ComputersInventory.Update() returned %s, want %s", formatWithSpew(computer), formatWithSpew(want))
+	}
+}
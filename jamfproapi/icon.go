@@ -41,7 +41,7 @@ const iconPath = "/v1/icon"
 func (s *IconService) Download(ctx context.Context, iconID int, options IconContentOptions) (*jamf.Response, error) {
 	params, err := query.Values(options)
 	if err != nil {
-		return nil, fmt.Errorf("query.Values(): %v", err)
+		return nil, fmt.Errorf("query.Values(): %w", err)
 	}
 
 	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
@@ -53,7 +53,7 @@ func (s *IconService) Download(ctx context.Context, iconID int, options IconCont
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, fmt.Errorf("client.Get(): %w", err)
 	}
 
 	return resp, nil
@@ -68,41 +68,47 @@ func (s *IconService) Get(ctx context.Context, iconID int) (*Icon, *jamf.Respons
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var icon Icon
 	if err := json.Unmarshal(respBody, &icon); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &icon, resp, nil
 }
 
 func (s *IconService) Upload(ctx context.Context, iconName string, src io.Reader) (*Icon, *jamf.Response, error) {
+	return s.UploadWithProgress(ctx, iconName, src, nil)
+}
+
+// UploadWithProgress is Upload reporting the progress of the upload to progress.
+// When ctx is canceled, the upload is aborted and the returned error satisfies errors.Is(err, context.Canceled).
+func (s *IconService) UploadWithProgress(ctx context.Context, iconName string, src io.Reader, progress jamf.ProgressFunc) (*Icon, *jamf.Response, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 	part, err := writer.CreateFormFile("file", iconName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("writer.CreateFormFile(): %v", err)
+		return nil, nil, fmt.Errorf("writer.CreateFormFile(): %w", err)
 	}
 
 	_, err = io.Copy(part, src)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.Copy(): %v", err)
+		return nil, nil, fmt.Errorf("io.Copy(): %w", err)
 	}
 
 	contentType := writer.FormDataContentType()
 
 	err = writer.Close()
 	if err != nil {
-		return nil, nil, fmt.Errorf("writer.Close(): %v", err)
+		return nil, nil, fmt.Errorf("writer.Close(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -112,21 +118,22 @@ func (s *IconService) Upload(ctx context.Context, iconName string, src io.Reader
 		},
 		ContentType: contentType,
 		Body:        body,
+		Progress:    progress,
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var icon Icon
 	if err := json.Unmarshal(respBody, &icon); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &icon, resp, nil
@@ -0,0 +1,437 @@
+package jamfproapi
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+// JCDSService manages the files of Jamf Cloud Distribution Service (JCDS 2).
+type JCDSService service
+
+type JCDSFile struct {
+	FileName *string `json:"fileName,omitempty"`
+	Length   *int64  `json:"length,omitempty"`
+	MD5      *string `json:"md5,omitempty"`
+	Region   *string `json:"region,omitempty"`
+	SHA3     *string `json:"sha3,omitempty"`
+}
+
+// JCDSCredentials are the temporary credentials of the S3-compatible storage of JCDS.
+type JCDSCredentials struct {
+	AccessKeyID     *string `json:"accessKeyID,omitempty"`
+	SecretAccessKey *string `json:"secretAccessKey,omitempty"`
+	SessionToken    *string `json:"sessionToken,omitempty"`
+	Region          *string `json:"region,omitempty"`
+	Expiration      *string `json:"expiration,omitempty"`
+	BucketName      *string `json:"bucketName,omitempty"`
+	Path            *string `json:"path,omitempty"`
+	UUID            *string `json:"uuid,omitempty"`
+}
+
+type JCDSDownload struct {
+	URI *string `json:"uri,omitempty"`
+}
+
+const (
+	jcdsFilesPath             = "/v1/jcds/files"
+	jcdsRenewCredentialsPath  = "/v1/jcds/renew-credentials"
+	jcdsRefreshInventoryPath  = "/v1/jcds/refresh-inventory"
+	jcdsCredentialsRenewAhead = 5 * time.Minute
+
+	// DefaultJCDSPartSize is the size of the parts of a multipart upload when JCDSUploadInput.PartSize is 0.
+	DefaultJCDSPartSize = 16 * 1024 * 1024
+	// MinJCDSPartSize is the minimum size of the parts of a multipart upload, which is required by S3.
+	MinJCDSPartSize = 5 * 1024 * 1024
+)
+
+// minJCDSPartSize is MinJCDSPartSize, which is lowered by the tests to upload small files in several parts.
+var minJCDSPartSize int64 = MinJCDSPartSize
+
+func (s *JCDSService) List(ctx context.Context) ([]JCDSFile, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: jcdsFilesPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var files []JCDSFile
+	if err := json.Unmarshal(respBody, &files); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return files, resp, nil
+}
+
+// CreateCredentials returns the credentials to upload a file to JCDS.
+func (s *JCDSService) CreateCredentials(ctx context.Context) (*JCDSCredentials, *jamf.Response, error) {
+	return s.credentials(ctx, jcdsFilesPath)
+}
+
+// RenewCredentials returns new credentials for the upload in progress, e.g. when the credentials are about to expire.
+func (s *JCDSService) RenewCredentials(ctx context.Context) (*JCDSCredentials, *jamf.Response, error) {
+	return s.credentials(ctx, jcdsRenewCredentialsPath)
+}
+
+func (s *JCDSService) credentials(ctx context.Context, entity string) (*JCDSCredentials, *jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK, http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var credentials JCDSCredentials
+	if err := json.Unmarshal(respBody, &credentials); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &credentials, resp, nil
+}
+
+// GetDownload returns the temporary URI to download a file.
+func (s *JCDSService) GetDownload(ctx context.Context, fileName string) (*JCDSDownload, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(jcdsFilesPath, url.PathEscape(fileName)),
+			Route:  path.Join(jcdsFilesPath, "{fileName}"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var download JCDSDownload
+	if err := json.Unmarshal(respBody, &download); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &download, resp, nil
+}
+
+// Download writes a file to dst, and returns the number of bytes written.
+func (s *JCDSService) Download(ctx context.Context, fileName string, dst io.Writer) (int64, error) {
+	download, _, err := s.GetDownload(ctx, fileName)
+	if err != nil {
+		return 0, err
+	}
+	if download.URI == nil {
+		return 0, errors.New("JCDSService.Download(): download URI is not returned")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *download.URI, http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext(): %w", err)
+	}
+
+	resp, err := s.client.HttpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("http.Client#Do(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, &JCDSStorageError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+
+	n, err := io.Copy(dst, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("io.Copy(): %w", err)
+	}
+
+	return n, nil
+}
+
+func (s *JCDSService) Delete(ctx context.Context, fileName string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(jcdsFilesPath, url.PathEscape(fileName)),
+			Route:  path.Join(jcdsFilesPath, "{fileName}"),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// RefreshInventory makes Jamf Pro reload the list of the files in JCDS, e.g. after a file is uploaded.
+func (s *JCDSService) RefreshInventory(ctx context.Context) (*jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: jcdsRefreshInventoryPath,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// JCDSUploadInput configures an upload to JCDS.
+type JCDSUploadInput struct {
+	FileName string
+	// Body is read sequentially, and only one part is held in memory at a time.
+	Body io.Reader
+	// Length is the length of Body, which is reported as the total of Progress. It may be 0 when it is unknown.
+	Length int64
+	// PartSize is the size of the parts, which must be at least MinJCDSPartSize. DefaultJCDSPartSize is used when it is 0.
+	PartSize int64
+	// UploadID resumes an upload which failed, given by JCDSUploadError.
+	// The parts already uploaded are verified by their MD5 and are not sent again.
+	UploadID *string
+	// ExpectedMD5 and ExpectedSHA512 are the hex-encoded checksums of Body.
+	// When Body does not match them, the upload is aborted before it is completed, and a *jamf.ChecksumMismatchError is returned.
+	ExpectedMD5    *string
+	ExpectedSHA512 *string
+	// Progress receives the progress of the upload after each part.
+	Progress jamf.ProgressFunc
+	// S3Endpoint overrides the endpoint of the storage, e.g. with a local S3-compatible server.
+	// Requests to it are path style, e.g. http://localhost:9000/{bucket}/{key}.
+	S3Endpoint *string
+}
+
+// JCDSUploadResult is the result of an upload to JCDS.
+type JCDSUploadResult struct {
+	FileName string
+	Key      string
+	UploadID string
+	Length   int64
+	// MD5 and SHA512 are the hex-encoded checksums of the uploaded file, e.g. for the hash of a package.
+	MD5    string
+	SHA512 string
+}
+
+// Checksum returns the checksum of the uploaded file, e.g. to set it to a package with SetChecksum.
+func (r *JCDSUploadResult) Checksum() *jamf.Checksum {
+	return &jamf.Checksum{MD5: r.MD5, SHA512: r.SHA512, Size: r.Length}
+}
+
+// JCDSUploadError is returned when an upload fails after it is started.
+// The upload can be resumed by setting UploadID to JCDSUploadInput.UploadID.
+type JCDSUploadError struct {
+	UploadID string
+	Err      error
+}
+
+func (e *JCDSUploadError) Error() string {
+	return fmt.Sprintf("JCDS upload %s failed: %v", e.UploadID, e.Err)
+}
+
+func (e *JCDSUploadError) Unwrap() error {
+	return e.Err
+}
+
+// Upload uploads a file to JCDS by an S3 multipart upload with the temporary credentials.
+// Each part is verified by S3 with Content-MD5, and the MD5 and SHA-512 of the whole file are returned.
+func (s *JCDSService) Upload(ctx context.Context, input *JCDSUploadInput) (*JCDSUploadResult, error) {
+	if input.FileName == "" {
+		return nil, errors.New("JCDSService.Upload(): cannot upload file with empty FileName")
+	}
+	if input.Body == nil {
+		return nil, errors.New("JCDSService.Upload(): cannot upload file with nil Body")
+	}
+	if input.PartSize < 0 || (input.PartSize > 0 && input.PartSize < minJCDSPartSize) {
+		return nil, fmt.Errorf("JCDSService.Upload(): cannot upload file with PartSize %d less than %d", input.PartSize, minJCDSPartSize)
+	}
+	partSize := input.PartSize
+	if partSize == 0 {
+		partSize = DefaultJCDSPartSize
+	}
+
+	credentials, _, err := s.CreateCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	storage := &s3Client{
+		httpClient:  s.client.HttpClient,
+		logger:      s.client.RetryableClient.Logger,
+		credentials: credentials,
+		now:         time.Now,
+	}
+	if input.S3Endpoint != nil {
+		endpoint, err := url.Parse(*input.S3Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("url.Parse(): %w", err)
+		}
+		storage.endpoint = endpoint
+	}
+
+	key := input.FileName
+	if p := strings.Trim(stringValue(credentials.Path), "/"); p != "" {
+		key = p + "/" + input.FileName
+	}
+
+	uploaded := map[int]string{}
+	var uploadID string
+	if input.UploadID != nil {
+		uploadID = *input.UploadID
+		uploaded, err = storage.listParts(ctx, key, uploadID)
+		if err != nil {
+			return nil, &JCDSUploadError{UploadID: uploadID, Err: fmt.Errorf("ListParts: %w", err)}
+		}
+	} else {
+		uploadID, err = storage.createMultipartUpload(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("CreateMultipartUpload: %w", err)
+		}
+	}
+
+	result := &JCDSUploadResult{FileName: input.FileName, Key: key, UploadID: uploadID}
+	parts, err := s.uploadParts(ctx, storage, input, key, uploadID, partSize, uploaded, result)
+	if err != nil {
+		return nil, &JCDSUploadError{UploadID: uploadID, Err: err}
+	}
+
+	checksum := result.Checksum()
+	if input.ExpectedMD5 != nil {
+		if err := checksum.Verify(jamf.HashTypeMD5, *input.ExpectedMD5); err != nil {
+			return nil, s.abortUpload(ctx, storage, key, uploadID, err)
+		}
+	}
+	if input.ExpectedSHA512 != nil {
+		if err := checksum.Verify(jamf.HashTypeSHA512, *input.ExpectedSHA512); err != nil {
+			return nil, s.abortUpload(ctx, storage, key, uploadID, err)
+		}
+	}
+
+	if err := storage.completeMultipartUpload(ctx, key, uploadID, parts); err != nil {
+		return nil, &JCDSUploadError{UploadID: uploadID, Err: fmt.Errorf("CompleteMultipartUpload: %w", err)}
+	}
+
+	return result, nil
+}
+
+func (s *JCDSService) uploadParts(ctx context.Context, storage *s3Client, input *JCDSUploadInput, key string, uploadID string, partSize int64, uploaded map[int]string, result *JCDSUploadResult) ([]s3CompletedPart, error) {
+	fileMD5 := md5.New()
+	fileSHA512 := sha512.New()
+	hashes := io.MultiWriter(fileMD5, fileSHA512)
+
+	var parts []s3CompletedPart
+	buf := make([]byte, partSize)
+	start := time.Now()
+	for partNumber := 1; ; partNumber++ {
+		n, err := io.ReadFull(input.Body, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("io.ReadFull(): %w", err)
+		}
+		// The last part is empty only when the whole file is empty, because a multipart upload needs a part.
+		if n == 0 && partNumber > 1 {
+			break
+		}
+		data := buf[:n]
+		_, _ = hashes.Write(data)
+		result.Length += int64(n)
+
+		partMD5 := md5.Sum(data)
+		etag := fmt.Sprintf("%q", hex.EncodeToString(partMD5[:]))
+		if uploaded[partNumber] != etag {
+			if err := s.renewCredentialsIfExpiring(ctx, storage); err != nil {
+				return nil, err
+			}
+			etag, err = storage.uploadPart(ctx, key, uploadID, partNumber, base64.StdEncoding.EncodeToString(partMD5[:]), data)
+			if err != nil {
+				return nil, fmt.Errorf("UploadPart %d: %w", partNumber, err)
+			}
+		}
+		parts = append(parts, s3CompletedPart{PartNumber: partNumber, ETag: etag})
+
+		if input.Progress != nil {
+			var rate float64
+			if elapsed := time.Since(start).Seconds(); elapsed > 0 {
+				rate = float64(result.Length) / elapsed
+			}
+			input.Progress(jamf.Progress{BytesSent: result.Length, Total: input.Length, BytesPerSecond: rate})
+		}
+
+		if int64(n) < partSize {
+			break
+		}
+	}
+
+	result.MD5 = hexSum(fileMD5)
+	result.SHA512 = hexSum(fileSHA512)
+
+	return parts, nil
+}
+
+// renewCredentialsIfExpiring renews the credentials before they expire during a long upload.
+func (s *JCDSService) renewCredentialsIfExpiring(ctx context.Context, storage *s3Client) error {
+	if storage.credentials.Expiration == nil {
+		return nil
+	}
+	expiration, err := time.Parse(time.RFC3339, *storage.credentials.Expiration)
+	if err != nil || storage.now().Add(jcdsCredentialsRenewAhead).Before(expiration) {
+		return nil
+	}
+
+	credentials, _, err := s.RenewCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	storage.credentials = credentials
+
+	return nil
+}
+
+func (s *JCDSService) abortUpload(ctx context.Context, storage *s3Client, key string, uploadID string, cause error) error {
+	if err := storage.abortMultipartUpload(ctx, key, uploadID); err != nil {
+		return &JCDSUploadError{UploadID: uploadID, Err: fmt.Errorf("%w, and AbortMultipartUpload: %v", cause, err)}
+	}
+	return cause
+}
+
+func hexSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,324 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+// JCDSStorageError is an error returned by the S3-compatible storage of JCDS.
+type JCDSStorageError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *JCDSStorageError) Error() string {
+	return fmt.Sprintf("JCDS storage returned status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// s3Client sends the requests of the S3 multipart upload, which are signed with AWS Signature Version 4.
+type s3Client struct {
+	httpClient  *http.Client
+	logger      interface{}
+	endpoint    *url.URL
+	credentials *JCDSCredentials
+	now         func() time.Time
+}
+
+// objectURL returns the URL of an object, which is virtual-hosted style for AWS and path style for a custom endpoint.
+func (c *s3Client) objectURL(key string, params url.Values) *url.URL {
+	escapedKey := s3URIEncode(key, false)
+
+	var u *url.URL
+	if c.endpoint != nil {
+		u = &url.URL{
+			Scheme:  c.endpoint.Scheme,
+			Host:    c.endpoint.Host,
+			Path:    strings.TrimSuffix(c.endpoint.Path, "/") + "/" + stringValue(c.credentials.BucketName) + "/" + key,
+			RawPath: strings.TrimSuffix(c.endpoint.EscapedPath(), "/") + "/" + s3URIEncode(stringValue(c.credentials.BucketName), true) + "/" + escapedKey,
+		}
+	} else {
+		u = &url.URL{
+			Scheme:  "https",
+			Host:    fmt.Sprintf("%s.s3.%s.amazonaws.com", stringValue(c.credentials.BucketName), stringValue(c.credentials.Region)),
+			Path:    "/" + key,
+			RawPath: "/" + escapedKey,
+		}
+	}
+	u.RawQuery = s3CanonicalQuery(params)
+
+	return u
+}
+
+func (c *s3Client) do(ctx context.Context, method string, key string, params url.Values, header http.Header, body []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.objectURL(key, params).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("http.NewRequestWithContext(): %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	signS3Request(req, c.credentials, body, c.now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("http.Client#Do(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, c.logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		storageErr := &JCDSStorageError{StatusCode: resp.StatusCode}
+		var data struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		}
+		if err := xml.Unmarshal(respBody, &data); err == nil {
+			storageErr.Code = data.Code
+			storageErr.Message = data.Message
+		}
+		return resp, respBody, storageErr
+	}
+
+	return resp, respBody, nil
+}
+
+func (c *s3Client) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	_, respBody, err := c.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var data struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err != nil {
+		return "", fmt.Errorf("xml.Unmarshal(): %w", err)
+	}
+
+	return data.UploadID, nil
+}
+
+func (c *s3Client) uploadPart(ctx context.Context, key string, uploadID string, partNumber int, contentMD5 string, data []byte) (string, error) {
+	params := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadID},
+	}
+	// S3 verifies the part with Content-MD5.
+	header := http.Header{"Content-Md5": {contentMD5}}
+
+	resp, _, err := c.do(ctx, http.MethodPut, key, params, header, data)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Header.Get("ETag"), nil
+}
+
+// listParts returns the ETags of the parts already uploaded, keyed by the part numbers.
+func (c *s3Client) listParts(ctx context.Context, key string, uploadID string) (map[int]string, error) {
+	parts := map[int]string{}
+	marker := ""
+	for {
+		params := url.Values{"uploadId": {uploadID}}
+		if marker != "" {
+			params.Set("part-number-marker", marker)
+		}
+
+		_, respBody, err := c.do(ctx, http.MethodGet, key, params, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var data struct {
+			IsTruncated          bool   `xml:"IsTruncated"`
+			NextPartNumberMarker string `xml:"NextPartNumberMarker"`
+			Parts                []struct {
+				PartNumber int    `xml:"PartNumber"`
+				ETag       string `xml:"ETag"`
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(respBody, &data); err != nil {
+			return nil, fmt.Errorf("xml.Unmarshal(): %w", err)
+		}
+		for _, p := range data.Parts {
+			parts[p.PartNumber] = p.ETag
+		}
+
+		if !data.IsTruncated || data.NextPartNumberMarker == "" {
+			return parts, nil
+		}
+		marker = data.NextPartNumberMarker
+	}
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (c *s3Client) completeMultipartUpload(ctx context.Context, key string, uploadID string, parts []s3CompletedPart) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+		Parts   []s3CompletedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return fmt.Errorf("xml.Marshal(): %w", err)
+	}
+
+	header := http.Header{"Content-Type": {"application/xml"}}
+	_, respBody, err := c.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, header, body)
+	if err != nil {
+		return err
+	}
+
+	// S3 may return an error in the body of a 200 response to CompleteMultipartUpload.
+	var data struct {
+		XMLName xml.Name
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(respBody, &data); err == nil && data.XMLName.Local == "Error" {
+		return &JCDSStorageError{StatusCode: http.StatusOK, Code: data.Code, Message: data.Message}
+	}
+
+	return nil
+}
+
+func (c *s3Client) abortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	_, _, err := c.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, nil)
+	return err
+}
+
+const s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+
+// signS3Request signs a request with AWS Signature Version 4.
+func signS3Request(req *http.Request, credentials *JCDSCredentials, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if token := stringValue(credentials.SessionToken); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		name := strings.ToLower(k)
+		if strings.HasPrefix(name, "x-amz-") || name == "content-md5" || name == "content-type" || name == "range" {
+			headers[name] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, stringValue(credentials.Region), "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+stringValue(credentials.SecretAccessKey)), date)
+	key = hmacSHA256(key, stringValue(credentials.Region))
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, stringValue(credentials.AccessKeyID), scope, signedHeaders, signature))
+}
+
+// s3CanonicalQuery encodes the query parameters sorted by their names as required by AWS Signature Version 4.
+func s3CanonicalQuery(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string{}, params[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, s3URIEncode(k, true)+"="+s3URIEncode(v, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode encodes every byte except the unreserved characters, and '/' unless encodeSlash is true.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,418 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+// ManagedSoftwareUpdatesService manages the OS updates of the devices with the declarative device management.
+type ManagedSoftwareUpdatesService service
+
+type ManagedSoftwareUpdateAvailableUpdates struct {
+	MacOS *[]string `json:"macOS,omitempty"`
+	IOS   *[]string `json:"iOS,omitempty"`
+}
+
+type ManagedSoftwareUpdateObjectType string
+
+const (
+	ManagedSoftwareUpdateObjectTypeComputer          ManagedSoftwareUpdateObjectType = "COMPUTER"
+	ManagedSoftwareUpdateObjectTypeMobileDevice      ManagedSoftwareUpdateObjectType = "MOBILE_DEVICE"
+	ManagedSoftwareUpdateObjectTypeAppleTV           ManagedSoftwareUpdateObjectType = "APPLE_TV"
+	ManagedSoftwareUpdateObjectTypeComputerGroup     ManagedSoftwareUpdateObjectType = "COMPUTER_GROUP"
+	ManagedSoftwareUpdateObjectTypeMobileDeviceGroup ManagedSoftwareUpdateObjectType = "MOBILE_DEVICE_GROUP"
+)
+
+type ManagedSoftwareUpdatePlanDevice struct {
+	DeviceID   *string                          `json:"deviceId,omitempty"`
+	ObjectType *ManagedSoftwareUpdateObjectType `json:"objectType,omitempty"`
+	Href       *string                          `json:"href,omitempty"`
+}
+
+// ManagedSoftwareUpdatePlanGroup is a static or smart group, e.g. the ID of classic.ComputerGroups with COMPUTER_GROUP.
+type ManagedSoftwareUpdatePlanGroup struct {
+	GroupID    *string                          `json:"groupId,omitempty"`
+	ObjectType *ManagedSoftwareUpdateObjectType `json:"objectType,omitempty"`
+}
+
+type ManagedSoftwareUpdatePlanConfig struct {
+	UpdateAction    *ManagedSoftwareUpdateUpdateAction `json:"updateAction,omitempty"`
+	VersionType     *ManagedSoftwareUpdateVersionType  `json:"versionType,omitempty"`
+	SpecificVersion *string                            `json:"specificVersion,omitempty"`
+	MaxDeferrals    *int                               `json:"maxDeferrals,omitempty"`
+	// ForceInstallLocalDateTime is the deadline in the local time of the device, e.g. 2024-12-25T21:00:00.
+	ForceInstallLocalDateTime *string `json:"forceInstallLocalDateTime,omitempty"`
+}
+
+type ManagedSoftwareUpdateUpdateAction string
+
+const (
+	ManagedSoftwareUpdateUpdateActionDownloadOnly                 ManagedSoftwareUpdateUpdateAction = "DOWNLOAD_ONLY"
+	ManagedSoftwareUpdateUpdateActionDownloadInstall              ManagedSoftwareUpdateUpdateAction = "DOWNLOAD_INSTALL"
+	ManagedSoftwareUpdateUpdateActionDownloadInstallAllowDeferral ManagedSoftwareUpdateUpdateAction = "DOWNLOAD_INSTALL_ALLOW_DEFERRAL"
+	ManagedSoftwareUpdateUpdateActionDownloadInstallRestart       ManagedSoftwareUpdateUpdateAction = "DOWNLOAD_INSTALL_RESTART"
+	ManagedSoftwareUpdateUpdateActionDownloadInstallSchedule      ManagedSoftwareUpdateUpdateAction = "DOWNLOAD_INSTALL_SCHEDULE"
+)
+
+type ManagedSoftwareUpdateVersionType string
+
+const (
+	ManagedSoftwareUpdateVersionTypeLatestMajor     ManagedSoftwareUpdateVersionType = "LATEST_MAJOR"
+	ManagedSoftwareUpdateVersionTypeLatestMinor     ManagedSoftwareUpdateVersionType = "LATEST_MINOR"
+	ManagedSoftwareUpdateVersionTypeLatestAny       ManagedSoftwareUpdateVersionType = "LATEST_ANY"
+	ManagedSoftwareUpdateVersionTypeSpecificVersion ManagedSoftwareUpdateVersionType = "SPECIFIC_VERSION"
+)
+
+// ManagedSoftwareUpdateCreatedPlan is a plan which is created for a device.
+type ManagedSoftwareUpdateCreatedPlan struct {
+	Device *ManagedSoftwareUpdatePlanDevice `json:"device,omitempty"`
+	PlanID *string                          `json:"planId,omitempty"`
+	Href   *string                          `json:"href,omitempty"`
+}
+
+type ManagedSoftwareUpdatePlan struct {
+	PlanUUID                  *string                            `json:"planUuid,omitempty"`
+	Device                    *ManagedSoftwareUpdatePlanDevice   `json:"device,omitempty"`
+	UpdateAction              *ManagedSoftwareUpdateUpdateAction `json:"updateAction,omitempty"`
+	VersionType               *ManagedSoftwareUpdateVersionType  `json:"versionType,omitempty"`
+	SpecificVersion           *string                            `json:"specificVersion,omitempty"`
+	MaxDeferrals              *int                               `json:"maxDeferrals,omitempty"`
+	ForceInstallLocalDateTime *string                            `json:"forceInstallLocalDateTime,omitempty"`
+	RecipeID                  *string                            `json:"recipeId,omitempty"`
+	Status                    *ManagedSoftwareUpdatePlanStatus   `json:"status,omitempty"`
+}
+
+type ManagedSoftwareUpdatePlanStatus struct {
+	State        *string   `json:"state,omitempty"`
+	ErrorReasons *[]string `json:"errorReasons,omitempty"`
+}
+
+type ListManagedSoftwareUpdatePlan struct {
+	TotalCount *int                         `json:"totalCount,omitempty"`
+	Plans      *[]ManagedSoftwareUpdatePlan `json:"results,omitempty"`
+}
+
+// ManagedSoftwareUpdatePlanEvents is the history of a plan. Events is a JSON encoded string of the events.
+type ManagedSoftwareUpdatePlanEvents struct {
+	ID     *string `json:"id,omitempty"`
+	Events *string `json:"events,omitempty"`
+}
+
+// ManagedSoftwareUpdateFeatureToggle enables the managed software updates instead of the legacy software update commands.
+type ManagedSoftwareUpdateFeatureToggle struct {
+	Toggle *bool `json:"toggle,omitempty"`
+}
+
+const (
+	managedSoftwareUpdatesAvailableUpdatesPath = "/v1/managed-software-updates/available-updates"
+	managedSoftwareUpdatesPlansPath            = "/v1/managed-software-updates/plans"
+	managedSoftwareUpdatesFeatureTogglePath    = "/v1/managed-software-updates/plans/feature-toggle"
+)
+
+// CreateGroupPlans creates the plans for the members of the group, and returns the plans, one for each device.
+func (s *ManagedSoftwareUpdatesService) CreateGroupPlans(ctx context.Context, group *ManagedSoftwareUpdatePlanGroup, config *ManagedSoftwareUpdatePlanConfig) ([]ManagedSoftwareUpdateCreatedPlan, *jamf.Response, error) {
+	if group == nil || group.GroupID == nil || group.ObjectType == nil {
+		return nil, nil, errors.New("ManagedSoftwareUpdatesService.CreateGroupPlans(): cannot create plans with nil GroupID or ObjectType of group")
+	}
+	if config == nil || config.UpdateAction == nil || config.VersionType == nil {
+		return nil, nil, errors.New("ManagedSoftwareUpdatesService.CreateGroupPlans(): cannot create plans with nil UpdateAction or VersionType of config")
+	}
+
+	body, err := json.Marshal(struct {
+		Group  *ManagedSoftwareUpdatePlanGroup  `json:"group"`
+		Config *ManagedSoftwareUpdatePlanConfig `json:"config"`
+	}{
+		Group:  group,
+		Config: config,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return s.createPlans(ctx, path.Join(managedSoftwareUpdatesPlansPath, "group"), body)
+}
+
+// CreatePlans creates the plans for the devices, and returns the plans, one for each device.
+func (s *ManagedSoftwareUpdatesService) CreatePlans(ctx context.Context, devices []ManagedSoftwareUpdatePlanDevice, config *ManagedSoftwareUpdatePlanConfig) ([]ManagedSoftwareUpdateCreatedPlan, *jamf.Response, error) {
+	if len(devices) == 0 {
+		return nil, nil, errors.New("ManagedSoftwareUpdatesService.CreatePlans(): cannot create plans with empty devices")
+	}
+	if config == nil || config.UpdateAction == nil || config.VersionType == nil {
+		return nil, nil, errors.New("ManagedSoftwareUpdatesService.CreatePlans(): cannot create plans with nil UpdateAction or VersionType of config")
+	}
+
+	body, err := json.Marshal(struct {
+		Devices []ManagedSoftwareUpdatePlanDevice `json:"devices"`
+		Config  *ManagedSoftwareUpdatePlanConfig  `json:"config"`
+	}{
+		Devices: devices,
+		Config:  config,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	return s.createPlans(ctx, managedSoftwareUpdatesPlansPath, body)
+}
+
+func (s *ManagedSoftwareUpdatesService) createPlans(ctx context.Context, entity string, body []byte) ([]ManagedSoftwareUpdateCreatedPlan, *jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: entity,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		Plans []ManagedSoftwareUpdateCreatedPlan `json:"plans"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return data.Plans, resp, nil
+}
+
+// GetAvailableUpdates returns the OS versions which can be specified as SpecificVersion of the plans.
+func (s *ManagedSoftwareUpdatesService) GetAvailableUpdates(ctx context.Context) (*ManagedSoftwareUpdateAvailableUpdates, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: managedSoftwareUpdatesAvailableUpdatesPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		AvailableUpdates ManagedSoftwareUpdateAvailableUpdates `json:"availableUpdates"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &data.AvailableUpdates, resp, nil
+}
+
+func (s *ManagedSoftwareUpdatesService) GetFeatureToggle(ctx context.Context) (*ManagedSoftwareUpdateFeatureToggle, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: managedSoftwareUpdatesFeatureTogglePath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var featureToggle ManagedSoftwareUpdateFeatureToggle
+	if err := json.Unmarshal(respBody, &featureToggle); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &featureToggle, resp, nil
+}
+
+// GetPlan returns the plan with its status.
+func (s *ManagedSoftwareUpdatesService) GetPlan(ctx context.Context, planID string) (*ManagedSoftwareUpdatePlan, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(managedSoftwareUpdatesPlansPath, planID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var plan ManagedSoftwareUpdatePlan
+	if err := json.Unmarshal(respBody, &plan); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &plan, resp, nil
+}
+
+func (s *ManagedSoftwareUpdatesService) GetPlanEvents(ctx context.Context, planID string) (*ManagedSoftwareUpdatePlanEvents, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(managedSoftwareUpdatesPlansPath, planID, "events"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var planEvents ManagedSoftwareUpdatePlanEvents
+	if err := json.Unmarshal(respBody, &planEvents); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &planEvents, resp, nil
+}
+
+// ListGroupPlans returns the plans of the group. The API does not support the pagination.
+func (s *ManagedSoftwareUpdatesService) ListGroupPlans(ctx context.Context, groupID string, groupType ManagedSoftwareUpdateObjectType) (*ListManagedSoftwareUpdatePlan, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(managedSoftwareUpdatesPlansPath, "group", groupID),
+			Params: url.Values{"group-type": {string(groupType)}},
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listPlan ListManagedSoftwareUpdatePlan
+	if err := json.Unmarshal(respBody, &listPlan); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listPlan, resp, nil
+}
+
+func (s *ManagedSoftwareUpdatesService) ListPlans(ctx context.Context, options ListOptions) (*ListManagedSoftwareUpdatePlan, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: managedSoftwareUpdatesPlansPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listPlan ListManagedSoftwareUpdatePlan
+	if err := json.Unmarshal(respBody, &listPlan); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listPlan, resp, nil
+}
+
+func (s *ManagedSoftwareUpdatesService) ListAllPlans(ctx context.Context, options ListAllOptions) ([]ManagedSoftwareUpdatePlan, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]ManagedSoftwareUpdatePlan, int, error) {
+		list, _, err := s.ListPlans(ctx, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Plans, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+// UpdateFeatureToggle switches the feature toggle. The switch takes a while at the server side.
+func (s *ManagedSoftwareUpdatesService) UpdateFeatureToggle(ctx context.Context, featureToggle *ManagedSoftwareUpdateFeatureToggle) (*ManagedSoftwareUpdateFeatureToggle, *jamf.Response, error) {
+	if featureToggle == nil || featureToggle.Toggle == nil {
+		return nil, nil, errors.New("ManagedSoftwareUpdatesService.UpdateFeatureToggle(): cannot update feature toggle with nil Toggle")
+	}
+
+	// Toggle must be sent even if it is false, so it is not marshalled with omitempty.
+	body, err := json.Marshal(struct {
+		Toggle bool `json:"toggle"`
+	}{
+		Toggle: *featureToggle.Toggle,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: managedSoftwareUpdatesFeatureTogglePath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Put(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newFeatureToggle ManagedSoftwareUpdateFeatureToggle
+	if err := json.Unmarshal(respBody, &newFeatureToggle); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newFeatureToggle, resp, nil
+}
@@ -0,0 +1,377 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestManagedSoftwareUpdatesService_CreateGroupPlans(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath, "group"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"group":{"groupId":"1","objectType":"COMPUTER_GROUP"},"config":{"updateAction":"DOWNLOAD_INSTALL_SCHEDULE","versionType":"SPECIFIC_VERSION","specificVersion":"14.4.1","forceInstallLocalDateTime":"2024-12-25T21:00:00"}}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"plans": [
+				{
+					"device": {
+						"deviceId": "1",
+						"objectType": "COMPUTER",
+						"href": "https://yourJamfProUrl.jamf/api/v1/computers-inventory/1"
+					},
+					"planId": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937",
+					"href": "https://yourJamfProUrl.jamf/api/v1/managed-software-updates/plans/aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	plans, _, err := client.ManagedSoftwareUpdates.CreateGroupPlans(ctx, &ManagedSoftwareUpdatePlanGroup{
+		GroupID:    ptr("1"),
+		ObjectType: ptr(ManagedSoftwareUpdateObjectTypeComputerGroup),
+	}, &ManagedSoftwareUpdatePlanConfig{
+		UpdateAction:              ptr(ManagedSoftwareUpdateUpdateActionDownloadInstallSchedule),
+		VersionType:               ptr(ManagedSoftwareUpdateVersionTypeSpecificVersion),
+		SpecificVersion:           ptr("14.4.1"),
+		ForceInstallLocalDateTime: ptr("2024-12-25T21:00:00"),
+	})
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.CreateGroupPlans(): %v", err)
+	}
+
+	want := []ManagedSoftwareUpdateCreatedPlan{
+		{
+			Device: &ManagedSoftwareUpdatePlanDevice{
+				DeviceID:   ptr("1"),
+				ObjectType: ptr(ManagedSoftwareUpdateObjectTypeComputer),
+				Href:       ptr("https://yourJamfProUrl.jamf/api/v1/computers-inventory/1"),
+			},
+			PlanID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+			Href:   ptr("https://yourJamfProUrl.jamf/api/v1/managed-software-updates/plans/aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		},
+	}
+	if !cmp.Equal(plans, want) {
+		t.Errorf("ManagedSoftwareUpdates.CreateGroupPlans() returned %s, want %s", formatWithSpew(plans), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_CreatePlans(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"devices":[{"deviceId":"1","objectType":"MOBILE_DEVICE"}],"config":{"updateAction":"DOWNLOAD_INSTALL_ALLOW_DEFERRAL","versionType":"LATEST_MINOR","maxDeferrals":3}}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"plans": [
+				{
+					"device": {
+						"deviceId": "1",
+						"objectType": "MOBILE_DEVICE"
+					},
+					"planId": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	plans, _, err := client.ManagedSoftwareUpdates.CreatePlans(ctx, []ManagedSoftwareUpdatePlanDevice{
+		{
+			DeviceID:   ptr("1"),
+			ObjectType: ptr(ManagedSoftwareUpdateObjectTypeMobileDevice),
+		},
+	}, &ManagedSoftwareUpdatePlanConfig{
+		UpdateAction: ptr(ManagedSoftwareUpdateUpdateActionDownloadInstallAllowDeferral),
+		VersionType:  ptr(ManagedSoftwareUpdateVersionTypeLatestMinor),
+		MaxDeferrals: ptr(3),
+	})
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.CreatePlans(): %v", err)
+	}
+
+	want := []ManagedSoftwareUpdateCreatedPlan{
+		{
+			Device: &ManagedSoftwareUpdatePlanDevice{
+				DeviceID:   ptr("1"),
+				ObjectType: ptr(ManagedSoftwareUpdateObjectTypeMobileDevice),
+			},
+			PlanID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		},
+	}
+	if !cmp.Equal(plans, want) {
+		t.Errorf("ManagedSoftwareUpdates.CreatePlans() returned %s, want %s", formatWithSpew(plans), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_GetAvailableUpdates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesAvailableUpdatesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"availableUpdates": {
+				"macOS": ["14.4.1", "13.6.6"],
+				"iOS": ["17.4.1"]
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	availableUpdates, _, err := client.ManagedSoftwareUpdates.GetAvailableUpdates(ctx)
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.GetAvailableUpdates(): %v", err)
+	}
+
+	want := &ManagedSoftwareUpdateAvailableUpdates{
+		MacOS: &[]string{"14.4.1", "13.6.6"},
+		IOS:   &[]string{"17.4.1"},
+	}
+	if !cmp.Equal(availableUpdates, want) {
+		t.Errorf("ManagedSoftwareUpdates.GetAvailableUpdates() returned %s, want %s", formatWithSpew(availableUpdates), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_GetFeatureToggle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesFeatureTogglePath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{"toggle": true}`)))
+	})
+
+	ctx := context.Background()
+	featureToggle, _, err := client.ManagedSoftwareUpdates.GetFeatureToggle(ctx)
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.GetFeatureToggle(): %v", err)
+	}
+
+	want := &ManagedSoftwareUpdateFeatureToggle{Toggle: ptr(true)}
+	if !cmp.Equal(featureToggle, want) {
+		t.Errorf("ManagedSoftwareUpdates.GetFeatureToggle() returned %s, want %s", formatWithSpew(featureToggle), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_GetPlan(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"planUuid": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937",
+			"device": {
+				"deviceId": "1",
+				"objectType": "COMPUTER"
+			},
+			"updateAction": "DOWNLOAD_INSTALL_SCHEDULE",
+			"versionType": "SPECIFIC_VERSION",
+			"specificVersion": "14.4.1",
+			"maxDeferrals": 0,
+			"forceInstallLocalDateTime": "2024-12-25T21:00:00",
+			"recipeId": "-1",
+			"status": {
+				"state": "PlanFailed",
+				"errorReasons": ["SPECIFIC_VERSION_UNAVAILABLE"]
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	plan, _, err := client.ManagedSoftwareUpdates.GetPlan(ctx, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.GetPlan(): %v", err)
+	}
+
+	want := &ManagedSoftwareUpdatePlan{
+		PlanUUID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+		Device: &ManagedSoftwareUpdatePlanDevice{
+			DeviceID:   ptr("1"),
+			ObjectType: ptr(ManagedSoftwareUpdateObjectTypeComputer),
+		},
+		UpdateAction:              ptr(ManagedSoftwareUpdateUpdateActionDownloadInstallSchedule),
+		VersionType:               ptr(ManagedSoftwareUpdateVersionTypeSpecificVersion),
+		SpecificVersion:           ptr("14.4.1"),
+		MaxDeferrals:              ptr(0),
+		ForceInstallLocalDateTime: ptr("2024-12-25T21:00:00"),
+		RecipeID:                  ptr("-1"),
+		Status: &ManagedSoftwareUpdatePlanStatus{
+			State:        ptr("PlanFailed"),
+			ErrorReasons: &[]string{"SPECIFIC_VERSION_UNAVAILABLE"},
+		},
+	}
+	if !cmp.Equal(plan, want) {
+		t.Errorf("ManagedSoftwareUpdates.GetPlan() returned %s, want %s", formatWithSpew(plan), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_GetPlanEvents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937", "events"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"events": "{\"events\":[{\"type\":\".PlanCreatedEvent\"}]}"
+		}`)))
+	})
+
+	ctx := context.Background()
+	planEvents, _, err := client.ManagedSoftwareUpdates.GetPlanEvents(ctx, "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.GetPlanEvents(): %v", err)
+	}
+
+	want := &ManagedSoftwareUpdatePlanEvents{
+		ID:     ptr("1"),
+		Events: ptr(`{"events":[{"type":".PlanCreatedEvent"}]}`),
+	}
+	if !cmp.Equal(planEvents, want) {
+		t.Errorf("ManagedSoftwareUpdates.GetPlanEvents() returned %s, want %s", formatWithSpew(planEvents), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_ListGroupPlans(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath, "group", "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("group-type"), "COMPUTER_GROUP"; got != want {
+			t.Errorf("Query().Get(group-type) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"planUuid": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937",
+					"device": {
+						"deviceId": "1",
+						"objectType": "COMPUTER"
+					},
+					"status": {
+						"state": "PlanCompleted",
+						"errorReasons": []
+					}
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	plans, _, err := client.ManagedSoftwareUpdates.ListGroupPlans(ctx, "1", ManagedSoftwareUpdateObjectTypeComputerGroup)
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.ListGroupPlans(): %v", err)
+	}
+
+	want := &ListManagedSoftwareUpdatePlan{
+		TotalCount: ptr(1),
+		Plans: &[]ManagedSoftwareUpdatePlan{
+			{
+				PlanUUID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+				Device: &ManagedSoftwareUpdatePlanDevice{
+					DeviceID:   ptr("1"),
+					ObjectType: ptr(ManagedSoftwareUpdateObjectTypeComputer),
+				},
+				Status: &ManagedSoftwareUpdatePlanStatus{
+					State:        ptr("PlanCompleted"),
+					ErrorReasons: &[]string{},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(plans, want) {
+		t.Errorf("ManagedSoftwareUpdates.ListGroupPlans() returned %s, want %s", formatWithSpew(plans), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_ListAllPlans(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesPlansPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "0":
+			_, _ = w.Write(compactJSON([]byte(`{
+				"totalCount": 2,
+				"results": [
+					{"planUuid": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"}
+				]
+			}`)))
+		case "1":
+			_, _ = w.Write(compactJSON([]byte(`{
+				"totalCount": 2,
+				"results": [
+					{"planUuid": "bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937"}
+				]
+			}`)))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ctx := context.Background()
+	plans, err := client.ManagedSoftwareUpdates.ListAllPlans(ctx, ListAllOptions{
+		ListOptions: ListOptions{PageSize: ptr(1)},
+	})
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.ListAllPlans(): %v", err)
+	}
+
+	want := []ManagedSoftwareUpdatePlan{
+		{PlanUUID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")},
+		{PlanUUID: ptr("bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937")},
+	}
+	if !cmp.Equal(plans, want) {
+		t.Errorf("ManagedSoftwareUpdates.ListAllPlans() returned %s, want %s", formatWithSpew(plans), formatWithSpew(want))
+	}
+}
+
+func TestManagedSoftwareUpdatesService_UpdateFeatureToggle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(managedSoftwareUpdatesFeatureTogglePath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte(`{"toggle":false}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{"toggle": false}`)))
+	})
+
+	ctx := context.Background()
+	featureToggle, _, err := client.ManagedSoftwareUpdates.UpdateFeatureToggle(ctx, &ManagedSoftwareUpdateFeatureToggle{Toggle: ptr(false)})
+	if err != nil {
+		t.Fatalf("ManagedSoftwareUpdates.UpdateFeatureToggle(): %v", err)
+	}
+
+	want := &ManagedSoftwareUpdateFeatureToggle{Toggle: ptr(false)}
+	if !cmp.Equal(featureToggle, want) {
+		t.Errorf("ManagedSoftwareUpdates.UpdateFeatureToggle() returned %s, want %s", formatWithSpew(featureToggle), formatWithSpew(want))
+	}
+}
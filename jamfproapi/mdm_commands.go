@@ -0,0 +1,312 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MDMCommandsService service
+
+type MDMCommandType string
+
+const (
+	MDMCommandTypeDeclarativeManagement MDMCommandType = "DECLARATIVE_MANAGEMENT"
+	MDMCommandTypeDeviceLock            MDMCommandType = "DEVICE_LOCK"
+	MDMCommandTypeEnableLostMode        MDMCommandType = "ENABLE_LOST_MODE"
+	MDMCommandTypeEraseDevice           MDMCommandType = "ERASE_DEVICE"
+	MDMCommandTypeInstallProfile        MDMCommandType = "INSTALL_PROFILE"
+	MDMCommandTypeRestartDevice         MDMCommandType = "RESTART_DEVICE"
+	MDMCommandTypeSettings              MDMCommandType = "SETTINGS"
+	MDMCommandTypeShutDownDevice        MDMCommandType = "SHUT_DOWN_DEVICE"
+)
+
+type MDMCommandRequest struct {
+	ClientData  *[]MDMCommandClientData `json:"clientData,omitempty"`
+	CommandData *MDMCommandData         `json:"commandData,omitempty"`
+}
+
+// MDMCommandClientData is a device which the command is sent to.
+// ManagementID is the managementId of the general section of the inventory, not the ID of the device.
+type MDMCommandClientData struct {
+	ManagementID *string `json:"managementId,omitempty"`
+}
+
+// MDMCommandData is the payload of a command. Set only the fields which the CommandType accepts.
+type MDMCommandData struct {
+	CommandType *MDMCommandType `json:"commandType,omitempty"`
+
+	// DEVICE_LOCK and ERASE_DEVICE
+	PIN *string `json:"pin,omitempty"`
+	// DEVICE_LOCK
+	Message     *string `json:"message,omitempty"`
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+
+	// ERASE_DEVICE
+	ObliterationBehavior   *MDMCommandObliterationBehavior `json:"obliterationBehavior,omitempty"`
+	PreserveDataPlan       *bool                           `json:"preserveDataPlan,omitempty"`
+	DisallowProximitySetup *bool                           `json:"disallowProximitySetup,omitempty"`
+	ReturnToService        *MDMCommandReturnToService      `json:"returnToService,omitempty"`
+
+	// RESTART_DEVICE
+	RebuildKernelCache *bool     `json:"rebuildKernelCache,omitempty"`
+	KextPaths          *[]string `json:"kextPaths,omitempty"`
+	NotifyUser         *bool     `json:"notifyUser,omitempty"`
+
+	// ENABLE_LOST_MODE
+	LostModeMessage  *string `json:"lostModeMessage,omitempty"`
+	LostModePhone    *string `json:"lostModePhone,omitempty"`
+	LostModeFootnote *string `json:"lostModeFootnote,omitempty"`
+
+	// INSTALL_PROFILE
+	// Profile is the base64 encoded .mobileconfig.
+	Profile *string `json:"profile,omitempty"`
+
+	// DECLARATIVE_MANAGEMENT
+	// Data is the base64 encoded tokens of the declarations.
+	Data *string `json:"data,omitempty"`
+
+	// SETTINGS
+	DeviceName              *string                           `json:"deviceName,omitempty"`
+	TimeZone                *string                           `json:"timeZone,omitempty"`
+	Bluetooth               *bool                             `json:"bluetooth,omitempty"`
+	DataRoaming             *bool                             `json:"dataRoaming,omitempty"`
+	VoiceRoaming            *bool                             `json:"voiceRoaming,omitempty"`
+	PersonalHotspot         *bool                             `json:"personalHotspot,omitempty"`
+	DiagnosticSubmission    *bool                             `json:"diagnosticSubmission,omitempty"`
+	AppAnalytics            *bool                             `json:"appAnalytics,omitempty"`
+	MaximumResidentUsers    *int                              `json:"maximumResidentUsers,omitempty"`
+	PasscodeLockGracePeriod *int                              `json:"passcodeLockGracePeriod,omitempty"`
+	SoftwareUpdateSettings  *MDMCommandSoftwareUpdateSettings `json:"softwareUpdateSettings,omitempty"`
+}
+
+type MDMCommandObliterationBehavior string
+
+const (
+	MDMCommandObliterationBehaviorDefault               MDMCommandObliterationBehavior = "Default"
+	MDMCommandObliterationBehaviorDoNotObliterate       MDMCommandObliterationBehavior = "DoNotObliterate"
+	MDMCommandObliterationBehaviorObliterateWithWarning MDMCommandObliterationBehavior = "ObliterateWithWarning"
+	MDMCommandObliterationBehaviorAlways                MDMCommandObliterationBehavior = "Always"
+)
+
+// MDMCommandReturnToService makes the device rejoin the network and enroll again after it is erased.
+type MDMCommandReturnToService struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// MDMProfileData and WifiProfileData are base64 encoded.
+	MDMProfileData  *string `json:"mdmProfileData,omitempty"`
+	WifiProfileData *string `json:"wifiProfileData,omitempty"`
+	BootstrapToken  *string `json:"bootstrapToken,omitempty"`
+}
+
+type MDMCommandSoftwareUpdateSettings struct {
+	RecommendationCadence *string `json:"recommendationCadence,omitempty"`
+}
+
+type MDMCommand struct {
+	UUID          *string           `json:"uuid,omitempty"`
+	Client        *MDMCommandClient `json:"client,omitempty"`
+	CommandState  *MDMCommandState  `json:"commandState,omitempty"`
+	CommandType   *MDMCommandType   `json:"commandType,omitempty"`
+	DateSent      *string           `json:"dateSent,omitempty"`
+	DateCompleted *string           `json:"dateCompleted,omitempty"`
+	ProfileID     *int              `json:"profileId,omitempty"`
+}
+
+type MDMCommandClient struct {
+	ManagementID *string `json:"managementId,omitempty"`
+	ClientType   *string `json:"clientType,omitempty"`
+}
+
+type MDMCommandState string
+
+const (
+	MDMCommandStatePending      MDMCommandState = "PENDING"
+	MDMCommandStateAcknowledged MDMCommandState = "ACKNOWLEDGED"
+	MDMCommandStateNotNow       MDMCommandState = "NOT_NOW"
+	MDMCommandStateError        MDMCommandState = "ERROR"
+)
+
+type ListMDMCommand struct {
+	TotalCount *int          `json:"totalCount,omitempty"`
+	Commands   *[]MDMCommand `json:"results,omitempty"`
+}
+
+const (
+	mdmCommandsPath     = "/v2/mdm/commands"
+	mdmRenewProfilePath = "/v1/mdm/renew-profile"
+)
+
+// Create sends the command to the devices and returns the UUIDs of the commands, one for each device.
+func (s *MDMCommandsService) Create(ctx context.Context, command *MDMCommandRequest) ([]string, *jamf.Response, error) {
+	if command.ClientData == nil || len(*command.ClientData) == 0 {
+		return nil, nil, errors.New("MDMCommandsService.Create(): cannot create command with empty ClientData")
+	}
+	if command.CommandData == nil || command.CommandData.CommandType == nil {
+		return nil, nil, errors.New("MDMCommandsService.Create(): cannot create command with nil CommandType of CommandData")
+	}
+
+	body, err := json.Marshal(command)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: mdmCommandsPath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data []struct {
+		ID   string `json:"id"`
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	commandUUIDs := make([]string, 0, len(data))
+	for _, d := range data {
+		commandUUIDs = append(commandUUIDs, d.ID)
+	}
+
+	return commandUUIDs, resp, nil
+}
+
+// Get returns the status of the command. The API has no endpoint for a command, so it filters the list by the UUID.
+// When the command is not found, the returned error satisfies jamf.IsNotFound.
+func (s *MDMCommandsService) Get(ctx context.Context, commandUUID string) (*MDMCommand, *jamf.Response, error) {
+	list, resp, err := s.List(ctx, ListOptions{
+		Filter: FilterValue(Eq("uuid", commandUUID)),
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if list.Commands == nil || len(*list.Commands) == 0 {
+		// The list succeeds without the command, so the error is made a 404 to satisfy jamf.IsNotFound.
+		errResp := &jamf.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("command %q is not found", commandUUID),
+		}
+		if resp != nil && resp.Request != nil {
+			errResp.Method = resp.Request.Method
+			errResp.URL = resp.Request.URL.String()
+		}
+		return nil, resp, fmt.Errorf("MDMCommandsService.Get(): %w", errResp)
+	}
+
+	return &(*list.Commands)[0], resp, nil
+}
+
+func (s *MDMCommandsService) List(ctx context.Context, options ListOptions) (*ListMDMCommand, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mdmCommandsPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listCommand ListMDMCommand
+	if err := json.Unmarshal(respBody, &listCommand); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listCommand, resp, nil
+}
+
+// ListAll fetches all pages of the list.
+func (s *MDMCommandsService) ListAll(ctx context.Context, options ListAllOptions) ([]MDMCommand, error) {
+	return ListAll(ctx, s.listPage, options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *MDMCommandsService) ListPager(options ListOptions) *Pager[MDMCommand] {
+	return NewPager(s.listPage, options)
+}
+
+func (s *MDMCommandsService) listPage(ctx context.Context, options ListOptions) ([]MDMCommand, int, error) {
+	list, _, err := s.List(ctx, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, totalCount := pageResults(list.Commands, list.TotalCount)
+	return results, totalCount, nil
+}
+
+// RenewMDMProfile renews the MDM profiles of the devices of the UDIDs, and returns the UDIDs which are not processed.
+func (s *MDMCommandsService) RenewMDMProfile(ctx context.Context, udids []string) ([]string, *jamf.Response, error) {
+	body, err := json.Marshal(struct {
+		UDIDs []string `json:"udids"`
+	}{
+		UDIDs: udids,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: mdmRenewProfilePath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		UDIDsNotProcessed struct {
+			UDIDs []string `json:"udids"`
+		} `json:"udidsNotProcessed"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return data.UDIDsNotProcessed.UDIDs, resp, nil
+}
@@ -0,0 +1,208 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+)
+
+func TestMDMCommandsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mdmCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"clientData":[{"managementId":"aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"},{"managementId":"bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937"}],"commandData":{"commandType":"DEVICE_LOCK","pin":"123456","message":"This device is lost.","phoneNumber":"0120-000-000"}}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`[
+			{
+				"id": "cccccccc-3f1e-4b3a-a5b3-ca0cd7430937",
+				"href": "https://yourJamfProUrl.jamf/api/v2/mdm/commands/cccccccc-3f1e-4b3a-a5b3-ca0cd7430937"
+			},
+			{
+				"id": "dddddddd-3f1e-4b3a-a5b3-ca0cd7430937",
+				"href": "https://yourJamfProUrl.jamf/api/v2/mdm/commands/dddddddd-3f1e-4b3a-a5b3-ca0cd7430937"
+			}
+		]`)))
+	})
+
+	ctx := context.Background()
+	commandUUIDs, _, err := client.MDMCommands.Create(ctx, &MDMCommandRequest{
+		ClientData: &[]MDMCommandClientData{
+			{ManagementID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")},
+			{ManagementID: ptr("bbbbbbbb-3f1e-4b3a-a5b3-ca0cd7430937")},
+		},
+		CommandData: &MDMCommandData{
+			CommandType: ptr(MDMCommandTypeDeviceLock),
+			PIN:         ptr("123456"),
+			Message:     ptr("This device is lost."),
+			PhoneNumber: ptr("0120-000-000"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("MDMCommands.Create(): %v", err)
+	}
+
+	want := []string{"cccccccc-3f1e-4b3a-a5b3-ca0cd7430937", "dddddddd-3f1e-4b3a-a5b3-ca0cd7430937"}
+	if !cmp.Equal(commandUUIDs, want) {
+		t.Errorf("MDMCommands.Create() returned %s, want %s", formatWithSpew(commandUUIDs), formatWithSpew(want))
+	}
+}
+
+func TestMDMCommandsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mdmCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `uuid=="cccccccc-3f1e-4b3a-a5b3-ca0cd7430937"`; got != want {
+			t.Errorf("Query().Get(filter) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"uuid": "cccccccc-3f1e-4b3a-a5b3-ca0cd7430937",
+					"client": {
+						"managementId": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937",
+						"clientType": "MOBILE_DEVICE"
+					},
+					"commandState": "ACKNOWLEDGED",
+					"commandType": "DEVICE_LOCK",
+					"dateSent": "2024-04-01T10:00:00Z",
+					"dateCompleted": "2024-04-01T10:00:05Z"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	command, _, err := client.MDMCommands.Get(ctx, "cccccccc-3f1e-4b3a-a5b3-ca0cd7430937")
+	if err != nil {
+		t.Fatalf("MDMCommands.Get(): %v", err)
+	}
+
+	want := &MDMCommand{
+		UUID: ptr("cccccccc-3f1e-4b3a-a5b3-ca0cd7430937"),
+		Client: &MDMCommandClient{
+			ManagementID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+			ClientType:   ptr("MOBILE_DEVICE"),
+		},
+		CommandState:  ptr(MDMCommandStateAcknowledged),
+		CommandType:   ptr(MDMCommandTypeDeviceLock),
+		DateSent:      ptr("2024-04-01T10:00:00Z"),
+		DateCompleted: ptr("2024-04-01T10:00:05Z"),
+	}
+	if !cmp.Equal(command, want) {
+		t.Errorf("MDMCommands.Get() returned %s, want %s", formatWithSpew(command), formatWithSpew(want))
+	}
+}
+
+func TestMDMCommandsService_Get_NotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mdmCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{"totalCount": 0, "results": []}`)))
+	})
+
+	ctx := context.Background()
+	_, _, err := client.MDMCommands.Get(ctx, "cccccccc-3f1e-4b3a-a5b3-ca0cd7430937")
+	if !jamf.IsNotFound(err) {
+		t.Errorf("MDMCommands.Get() returned %v, want an error satisfying jamf.IsNotFound", err)
+	}
+}
+
+func TestMDMCommandsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mdmCommandsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `clientManagementId=="aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"`; got != want {
+			t.Errorf("Query().Get(filter) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"uuid": "cccccccc-3f1e-4b3a-a5b3-ca0cd7430937",
+					"client": {
+						"managementId": "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937",
+						"clientType": "COMPUTER"
+					},
+					"commandState": "PENDING",
+					"commandType": "INSTALL_PROFILE",
+					"dateSent": "2024-04-01T10:00:00Z",
+					"profileId": 3
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	commands, _, err := client.MDMCommands.List(ctx, ListOptions{
+		Filter: FilterValue(Eq("clientManagementId", "aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937")),
+	})
+	if err != nil {
+		t.Fatalf("MDMCommands.List(): %v", err)
+	}
+
+	want := &ListMDMCommand{
+		TotalCount: ptr(1),
+		Commands: &[]MDMCommand{
+			{
+				UUID: ptr("cccccccc-3f1e-4b3a-a5b3-ca0cd7430937"),
+				Client: &MDMCommandClient{
+					ManagementID: ptr("aaaaaaaa-3f1e-4b3a-a5b3-ca0cd7430937"),
+					ClientType:   ptr("COMPUTER"),
+				},
+				CommandState: ptr(MDMCommandStatePending),
+				CommandType:  ptr(MDMCommandTypeInstallProfile),
+				DateSent:     ptr("2024-04-01T10:00:00Z"),
+				ProfileID:    ptr(3),
+			},
+		},
+	}
+	if !cmp.Equal(commands, want) {
+		t.Errorf("MDMCommands.List() returned %s, want %s", formatWithSpew(commands), formatWithSpew(want))
+	}
+}
+
+func TestMDMCommandsService_RenewMDMProfile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mdmRenewProfilePath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"udids":["0000-1111","2222-3333"]}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"udidsNotProcessed": {
+				"udids": ["2222-3333"]
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	udidsNotProcessed, _, err := client.MDMCommands.RenewMDMProfile(ctx, []string{"0000-1111", "2222-3333"})
+	if err != nil {
+		t.Fatalf("MDMCommands.RenewMDMProfile(): %v", err)
+	}
+
+	want := []string{"2222-3333"}
+	if !cmp.Equal(udidsNotProcessed, want) {
+		t.Errorf("MDMCommands.RenewMDMProfile() returned %s, want %s", formatWithSpew(udidsNotProcessed), formatWithSpew(want))
+	}
+}
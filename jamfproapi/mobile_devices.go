@@ -0,0 +1,368 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type MobileDevicesService service
+
+type MobileDevice struct {
+	ID                     *string `json:"id,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	SerialNumber           *string `json:"serialNumber,omitempty"`
+	WifiMacAddress         *string `json:"wifiMacAddress,omitempty"`
+	UDID                   *string `json:"udid,omitempty"`
+	PhoneNumber            *string `json:"phoneNumber,omitempty"`
+	Model                  *string `json:"model,omitempty"`
+	ModelIdentifier        *string `json:"modelIdentifier,omitempty"`
+	Username               *string `json:"username,omitempty"`
+	Type                   *string `json:"type,omitempty"`
+	ManagementID           *string `json:"managementId,omitempty"`
+	SoftwareUpdateDeviceID *string `json:"softwareUpdateDeviceId,omitempty"`
+}
+
+type ListMobileDevice struct {
+	TotalCount    *int            `json:"totalCount,omitempty"`
+	MobileDevices *[]MobileDevice `json:"results,omitempty"`
+}
+
+// MobileDeviceDetail is the full inventory of a mobile device.
+type MobileDeviceDetail struct {
+	ID                                 *string                           `json:"id,omitempty"`
+	Name                               *string                           `json:"name,omitempty"`
+	EnforceName                        *bool                             `json:"enforceName,omitempty"`
+	AssetTag                           *string                           `json:"assetTag,omitempty"`
+	LastInventoryUpdateTimestamp       *string                           `json:"lastInventoryUpdateTimestamp,omitempty"`
+	OSVersion                          *string                           `json:"osVersion,omitempty"`
+	OSRapidSecurityResponse            *string                           `json:"osRapidSecurityResponse,omitempty"`
+	OSBuild                            *string                           `json:"osBuild,omitempty"`
+	OSSupplementalBuildVersion         *string                           `json:"osSupplementalBuildVersion,omitempty"`
+	SoftwareUpdateDeviceID             *string                           `json:"softwareUpdateDeviceId,omitempty"`
+	SerialNumber                       *string                           `json:"serialNumber,omitempty"`
+	UDID                               *string                           `json:"udid,omitempty"`
+	IPAddress                          *string                           `json:"ipAddress,omitempty"`
+	WifiMacAddress                     *string                           `json:"wifiMacAddress,omitempty"`
+	BluetoothMacAddress                *string                           `json:"bluetoothMacAddress,omitempty"`
+	Managed                            *bool                             `json:"managed,omitempty"`
+	TimeZone                           *string                           `json:"timeZone,omitempty"`
+	InitialEntryTimestamp              *string                           `json:"initialEntryTimestamp,omitempty"`
+	LastEnrollmentTimestamp            *string                           `json:"lastEnrollmentTimestamp,omitempty"`
+	MDMProfileExpirationTimestamp      *string                           `json:"mdmProfileExpirationTimestamp,omitempty"`
+	DeviceOwnershipLevel               *string                           `json:"deviceOwnershipLevel,omitempty"`
+	EnrollmentMethod                   *string                           `json:"enrollmentMethod,omitempty"`
+	EnrollmentSessionTokenValid        *bool                             `json:"enrollmentSessionTokenValid,omitempty"`
+	DeclarativeDeviceManagementEnabled *bool                             `json:"declarativeDeviceManagementEnabled,omitempty"`
+	ManagementID                       *string                           `json:"managementId,omitempty"`
+	Site                               *MobileDeviceSite                 `json:"site,omitempty"`
+	ExtensionAttributes                *[]MobileDeviceExtensionAttribute `json:"extensionAttributes,omitempty"`
+	Location                           *MobileDeviceLocation             `json:"location,omitempty"`
+	Type                               *string                           `json:"type,omitempty"`
+	IOS                                *MobileDeviceIOS                  `json:"ios,omitempty"`
+}
+
+type MobileDeviceSite struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// MobileDeviceExtensionAttribute is the value of an extension attribute of a mobile device.
+// Only ID and Value are required to update it.
+type MobileDeviceExtensionAttribute struct {
+	ID                                  *string   `json:"id,omitempty"`
+	Name                                *string   `json:"name,omitempty"`
+	Type                                *string   `json:"type,omitempty"`
+	Value                               *[]string `json:"value,omitempty"`
+	ExtensionAttributeCollectionAllowed *bool     `json:"extensionAttributeCollectionAllowed,omitempty"`
+	InventoryDisplay                    *string   `json:"inventoryDisplay,omitempty"`
+}
+
+type MobileDeviceLocation struct {
+	Username     *string `json:"username,omitempty"`
+	RealName     *string `json:"realName,omitempty"`
+	EmailAddress *string `json:"emailAddress,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	PhoneNumber  *string `json:"phoneNumber,omitempty"`
+	DepartmentID *string `json:"departmentId,omitempty"`
+	BuildingID   *string `json:"buildingId,omitempty"`
+	Room         *string `json:"room,omitempty"`
+	Building     *string `json:"building,omitempty"`
+	Department   *string `json:"department,omitempty"`
+}
+
+// MobileDeviceIOS is the inventory of an iOS or iPadOS device.
+type MobileDeviceIOS struct {
+	Model                       *string                             `json:"model,omitempty"`
+	ModelIdentifier             *string                             `json:"modelIdentifier,omitempty"`
+	ModelNumber                 *string                             `json:"modelNumber,omitempty"`
+	Supervised                  *bool                               `json:"supervised,omitempty"`
+	BatteryLevel                *int                                `json:"batteryLevel,omitempty"`
+	LastBackupTimestamp         *string                             `json:"lastBackupTimestamp,omitempty"`
+	CapacityMB                  *int                                `json:"capacityMb,omitempty"`
+	AvailableMB                 *int                                `json:"availableMb,omitempty"`
+	PercentageUsed              *int                                `json:"percentageUsed,omitempty"`
+	Shared                      *bool                               `json:"shared,omitempty"`
+	DeviceLocatorServiceEnabled *bool                               `json:"deviceLocatorServiceEnabled,omitempty"`
+	DoNotDisturbEnabled         *bool                               `json:"doNotDisturbEnabled,omitempty"`
+	CloudBackupEnabled          *bool                               `json:"cloudBackupEnabled,omitempty"`
+	LastCloudBackupTimestamp    *string                             `json:"lastCloudBackupTimestamp,omitempty"`
+	LocationServicesEnabled     *bool                               `json:"locationServicesEnabled,omitempty"`
+	ITunesStoreAccountActive    *bool                               `json:"iTunesStoreAccountActive,omitempty"`
+	BLECapable                  *bool                               `json:"bleCapable,omitempty"`
+	Purchasing                  *MobileDevicePurchasing             `json:"purchasing,omitempty"`
+	Security                    *MobileDeviceSecurity               `json:"security,omitempty"`
+	Network                     *MobileDeviceNetwork                `json:"network,omitempty"`
+	Applications                *[]MobileDeviceApplication          `json:"applications,omitempty"`
+	Certificates                *[]MobileDeviceCertificate          `json:"certificates,omitempty"`
+	ConfigurationProfiles       *[]MobileDeviceConfigurationProfile `json:"configurationProfiles,omitempty"`
+}
+
+type MobileDevicePurchasing struct {
+	Purchased           *bool                             `json:"purchased,omitempty"`
+	Leased              *bool                             `json:"leased,omitempty"`
+	PONumber            *string                           `json:"poNumber,omitempty"`
+	Vendor              *string                           `json:"vendor,omitempty"`
+	AppleCareID         *string                           `json:"appleCareId,omitempty"`
+	PurchasePrice       *string                           `json:"purchasePrice,omitempty"`
+	PurchasingAccount   *string                           `json:"purchasingAccount,omitempty"`
+	PODate              *string                           `json:"poDate,omitempty"`
+	WarrantyExpiresDate *string                           `json:"warrantyExpiresDate,omitempty"`
+	LeaseExpiresDate    *string                           `json:"leaseExpiresDate,omitempty"`
+	LifeExpectancy      *int                              `json:"lifeExpectancy,omitempty"`
+	PurchasingContact   *string                           `json:"purchasingContact,omitempty"`
+	ExtensionAttributes *[]MobileDeviceExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceSecurity struct {
+	DataProtected                          *bool   `json:"dataProtected,omitempty"`
+	BlockLevelEncryptionCapable            *bool   `json:"blockLevelEncryptionCapable,omitempty"`
+	FileLevelEncryptionCapable             *bool   `json:"fileLevelEncryptionCapable,omitempty"`
+	PasscodePresent                        *bool   `json:"passcodePresent,omitempty"`
+	PasscodeCompliant                      *bool   `json:"passcodeCompliant,omitempty"`
+	PasscodeCompliantWithProfile           *bool   `json:"passcodeCompliantWithProfile,omitempty"`
+	HardwareEncryption                     *int    `json:"hardwareEncryption,omitempty"`
+	ActivationLockEnabled                  *bool   `json:"activationLockEnabled,omitempty"`
+	JailBreakDetected                      *bool   `json:"jailBreakDetected,omitempty"`
+	PasscodeLockGracePeriodEnforcedSeconds *int    `json:"passcodeLockGracePeriodEnforcedSeconds,omitempty"`
+	PersonalDeviceProfileCurrent           *bool   `json:"personalDeviceProfileCurrent,omitempty"`
+	LostModeEnabled                        *bool   `json:"lostModeEnabled,omitempty"`
+	LostModePersistent                     *bool   `json:"lostModePersistent,omitempty"`
+	LostModeMessage                        *string `json:"lostModeMessage,omitempty"`
+	LostModePhoneNumber                    *string `json:"lostModePhoneNumber,omitempty"`
+	LostModeFootnote                       *string `json:"lostModeFootnote,omitempty"`
+}
+
+type MobileDeviceNetwork struct {
+	CellularTechnology       *string `json:"cellularTechnology,omitempty"`
+	VoiceRoamingEnabled      *bool   `json:"voiceRoamingEnabled,omitempty"`
+	IMEI                     *string `json:"imei,omitempty"`
+	ICCID                    *string `json:"iccid,omitempty"`
+	MEID                     *string `json:"meid,omitempty"`
+	EID                      *string `json:"eid,omitempty"`
+	CarrierSettingsVersion   *string `json:"carrierSettingsVersion,omitempty"`
+	CurrentCarrierNetwork    *string `json:"currentCarrierNetwork,omitempty"`
+	CurrentMobileCountryCode *string `json:"currentMobileCountryCode,omitempty"`
+	CurrentMobileNetworkCode *string `json:"currentMobileNetworkCode,omitempty"`
+	HomeCarrierNetwork       *string `json:"homeCarrierNetwork,omitempty"`
+	HomeMobileCountryCode    *string `json:"homeMobileCountryCode,omitempty"`
+	HomeMobileNetworkCode    *string `json:"homeMobileNetworkCode,omitempty"`
+	DataRoamingEnabled       *bool   `json:"dataRoamingEnabled,omitempty"`
+	Roaming                  *bool   `json:"roaming,omitempty"`
+	PersonalHotspotEnabled   *bool   `json:"personalHotspotEnabled,omitempty"`
+	PhoneNumber              *string `json:"phoneNumber,omitempty"`
+}
+
+type MobileDeviceApplication struct {
+	Identifier       *string `json:"identifier,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Version          *string `json:"version,omitempty"`
+	ShortVersion     *string `json:"shortVersion,omitempty"`
+	ManagementStatus *string `json:"managementStatus,omitempty"`
+	ValidationStatus *bool   `json:"validationStatus,omitempty"`
+	BundleSize       *string `json:"bundleSize,omitempty"`
+	DynamicSize      *string `json:"dynamicSize,omitempty"`
+}
+
+type MobileDeviceCertificate struct {
+	CommonName          *string `json:"commonName,omitempty"`
+	Identity            *bool   `json:"identity,omitempty"`
+	ExpirationDateEpoch *string `json:"expirationDateEpoch,omitempty"`
+}
+
+type MobileDeviceConfigurationProfile struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Version     *string `json:"version,omitempty"`
+	UUID        *string `json:"uuid,omitempty"`
+	Identifier  *string `json:"identifier,omitempty"`
+}
+
+// MobileDeviceUpdate is the fields of a mobile device which can be updated.
+// The fields which are not set are not changed.
+type MobileDeviceUpdate struct {
+	Name                       *string                           `json:"name,omitempty"`
+	EnforceName                *bool                             `json:"enforceName,omitempty"`
+	AssetTag                   *string                           `json:"assetTag,omitempty"`
+	SiteID                     *string                           `json:"siteId,omitempty"`
+	TimeZone                   *string                           `json:"timeZone,omitempty"`
+	Location                   *MobileDeviceLocation             `json:"location,omitempty"`
+	UpdatedExtensionAttributes *[]MobileDeviceExtensionAttribute `json:"updatedExtensionAttributes,omitempty"`
+	IOS                        *MobileDeviceUpdateIOS            `json:"ios,omitempty"`
+}
+
+type MobileDeviceUpdateIOS struct {
+	Purchasing *MobileDevicePurchasing `json:"purchasing,omitempty"`
+}
+
+const mobileDevicesPath = "/v2/mobile-devices"
+
+func (s *MobileDevicesService) Get(ctx context.Context, mobileDeviceID string) (*MobileDevice, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, mobileDeviceID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDevice MobileDevice
+	if err := json.Unmarshal(respBody, &mobileDevice); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &mobileDevice, resp, nil
+}
+
+// GetDetail returns the full inventory of a mobile device.
+func (s *MobileDevicesService) GetDetail(ctx context.Context, mobileDeviceID string) (*MobileDeviceDetail, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, mobileDeviceID, "detail"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var mobileDevice MobileDeviceDetail
+	if err := json.Unmarshal(respBody, &mobileDevice); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &mobileDevice, resp, nil
+}
+
+func (s *MobileDevicesService) List(ctx context.Context, options ListOptions) (*ListMobileDevice, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: mobileDevicesPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listMobileDevice ListMobileDevice
+	if err := json.Unmarshal(respBody, &listMobileDevice); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listMobileDevice, resp, nil
+}
+
+// ListAll fetches all pages of the list.
+func (s *MobileDevicesService) ListAll(ctx context.Context, options ListAllOptions) ([]MobileDevice, error) {
+	return ListAll(ctx, s.listPage, options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *MobileDevicesService) ListPager(options ListOptions) *Pager[MobileDevice] {
+	return NewPager(s.listPage, options)
+}
+
+func (s *MobileDevicesService) listPage(ctx context.Context, options ListOptions) ([]MobileDevice, int, error) {
+	list, _, err := s.List(ctx, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, totalCount := pageResults(list.MobileDevices, list.TotalCount)
+	return results, totalCount, nil
+}
+
+// Update updates a mobile device with PATCH, and returns its full inventory.
+func (s *MobileDevicesService) Update(ctx context.Context, mobileDeviceID string, mobileDevice *MobileDeviceUpdate) (*MobileDeviceDetail, *jamf.Response, error) {
+	if mobileDevice == nil {
+		return nil, nil, errors.New("MobileDevicesService.Update(): cannot update nil mobile device")
+	}
+
+	body, err := json.Marshal(mobileDevice)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Patch(ctx, jamf.PatchHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(mobileDevicesPath, mobileDeviceID),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Patch(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newMobileDevice MobileDeviceDetail
+	if err := json.Unmarshal(respBody, &newMobileDevice); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newMobileDevice, resp, nil
+}
@@ -0,0 +1,218 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMobileDevicesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := "1"
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, mobileDeviceID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"name": "Test iPad",
+			"serialNumber": "DMPXK1A2B3C4",
+			"udid": "a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0",
+			"model": "iPad (10th generation)",
+			"modelIdentifier": "iPad13,18",
+			"type": "iOS"
+		}`)))
+	})
+
+	ctx := context.Background()
+	mobileDevice, _, err := client.MobileDevices.Get(ctx, mobileDeviceID)
+	if err != nil {
+		t.Fatalf("MobileDevices.Get(): %v", err)
+	}
+
+	want := &MobileDevice{
+		ID:              ptr("1"),
+		Name:            ptr("Test iPad"),
+		SerialNumber:    ptr("DMPXK1A2B3C4"),
+		UDID:            ptr("a7b2f3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0"),
+		Model:           ptr("iPad (10th generation)"),
+		ModelIdentifier: ptr("iPad13,18"),
+		Type:            ptr("iOS"),
+	}
+	if !cmp.Equal(mobileDevice, want) {
+		t.Errorf("MobileDevices.Get() returned %s, want %s", formatWithSpew(mobileDevice), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_GetDetail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := "1"
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, mobileDeviceID, "detail"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"name": "Test iPad",
+			"osVersion": "17.4",
+			"managed": true,
+			"site": {
+				"id": "-1",
+				"name": "None"
+			},
+			"location": {
+				"username": "user",
+				"buildingId": "1"
+			},
+			"type": "ios",
+			"ios": {
+				"supervised": true,
+				"batteryLevel": 87,
+				"security": {
+					"passcodePresent": true,
+					"lostModeEnabled": false
+				},
+				"applications": [
+					{
+						"identifier": "com.jamfsoftware.selfservice",
+						"name": "Self Service",
+						"version": "10.10.2"
+					}
+				]
+			}
+		}`)))
+	})
+
+	ctx := context.Background()
+	mobileDevice, _, err := client.MobileDevices.GetDetail(ctx, mobileDeviceID)
+	if err != nil {
+		t.Fatalf("MobileDevices.GetDetail(): %v", err)
+	}
+
+	want := &MobileDeviceDetail{
+		ID:        ptr("1"),
+		Name:      ptr("Test iPad"),
+		OSVersion: ptr("17.4"),
+		Managed:   ptr(true),
+		Site: &MobileDeviceSite{
+			ID:   ptr("-1"),
+			Name: ptr("None"),
+		},
+		Location: &MobileDeviceLocation{
+			Username:   ptr("user"),
+			BuildingID: ptr("1"),
+		},
+		Type: ptr("ios"),
+		IOS: &MobileDeviceIOS{
+			Supervised:   ptr(true),
+			BatteryLevel: ptr(87),
+			Security: &MobileDeviceSecurity{
+				PasscodePresent: ptr(true),
+				LostModeEnabled: ptr(false),
+			},
+			Applications: &[]MobileDeviceApplication{
+				{
+					Identifier: ptr("com.jamfsoftware.selfservice"),
+					Name:       ptr("Self Service"),
+					Version:    ptr("10.10.2"),
+				},
+			},
+		},
+	}
+	if !cmp.Equal(mobileDevice, want) {
+		t.Errorf("MobileDevices.GetDetail() returned %s, want %s", formatWithSpew(mobileDevice), formatWithSpew(want))
+	}
+}
+
+func TestMobileDevicesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("sort"), "name:asc"; got != want {
+			t.Errorf("Query().Get(sort) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": "1",
+					"name": "Test iPad",
+					"serialNumber": "DMPXK1A2B3C4"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	list, _, err := client.MobileDevices.List(ctx, ListOptions{Sort: &[]string{"name:asc"}})
+	if err != nil {
+		t.Fatalf("MobileDevices.List(): %v", err)
+	}
+
+	wantMobileDevices := &[]MobileDevice{
+		{
+			ID:           ptr("1"),
+			Name:         ptr("Test iPad"),
+			SerialNumber: ptr("DMPXK1A2B3C4"),
+		},
+	}
+	if !cmp.Equal(list.MobileDevices, wantMobileDevices) {
+		t.Errorf("MobileDevices.List() returned %s, want %s", formatWithSpew(list.MobileDevices), formatWithSpew(wantMobileDevices))
+	}
+
+	if wantTotalCount := 1; *list.TotalCount != wantTotalCount {
+		t.Errorf("MobileDevices.List() returned %s, want %s", formatWithSpew(list.TotalCount), formatWithSpew(wantTotalCount))
+	}
+}
+
+func TestMobileDevicesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mobileDeviceID := "1"
+
+	mux.HandleFunc(buildHandlePath(mobileDevicesPath, mobileDeviceID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, []byte(`{"assetTag":"A-1","updatedExtensionAttributes":[{"id":"2","value":["IT"]}]}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"assetTag": "A-1"
+		}`)))
+	})
+
+	ctx := context.Background()
+	mobileDevice, _, err := client.MobileDevices.Update(ctx, mobileDeviceID, &MobileDeviceUpdate{
+		AssetTag: ptr("A-1"),
+		UpdatedExtensionAttributes: &[]MobileDeviceExtensionAttribute{
+			{
+				ID:    ptr("2"),
+				Value: &[]string{"IT"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("MobileDevices.Update(): %v", err)
+	}
+
+	want := &MobileDeviceDetail{
+		ID:       ptr("1"),
+		AssetTag: ptr("A-1"),
+	}
+	if !cmp.Equal(mobileDevice, want) {
+		t.Errorf("MobileDevices.Update() returned %s, want %s", formatWithSpew(mobileDevice), formatWithSpew(want))
+	}
+}
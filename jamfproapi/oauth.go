@@ -0,0 +1,88 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type OAuthService service
+
+// ClientCredentialsAuthorizer is a Jamf Pro identity of an API client.
+// Passing it to the clients of the Classic API and the Jamf Pro API with jamf.WithAuthorizer authenticates them
+// with a single shared access token. It has no password, so it cannot authenticate the informal API.
+type ClientCredentialsAuthorizer struct {
+	// BaseURL is the base endpoint for Jamf Pro, e.g. https://yourdomain.jamfcloud.com
+	BaseURL      *url.URL
+	ClientID     string
+	ClientSecret string
+
+	once        sync.Once
+	tokenSource jamf.TokenSource
+}
+
+// TokenSource returns the token source of NewClientCredentialsTokenSource, which is created on the first call and shared after that.
+func (a *ClientCredentialsAuthorizer) TokenSource() jamf.TokenSource {
+	a.once.Do(func() {
+		a.tokenSource = newClientCredentialsTokenSource(newClient(a.BaseURL), a.ClientID, a.ClientSecret)
+	})
+
+	return a.tokenSource
+}
+
+// BasicAuth always returns false, because an API client has no password.
+func (a *ClientCredentialsAuthorizer) BasicAuth() (string, string, bool) {
+	return "", "", false
+}
+
+type OAuthToken struct {
+	AccessToken *string `json:"access_token,omitempty"`
+	Scope       *string `json:"scope,omitempty"`
+	TokenType   *string `json:"token_type,omitempty"`
+	ExpiresIn   *int    `json:"expires_in,omitempty"`
+}
+
+const oauthTokenPath = "/oauth/token"
+
+// Token obtains an access token for an API client with the client credentials grant.
+func (s *OAuthService) Token(ctx context.Context, clientID string, clientSecret string) (*OAuthToken, *jamf.Response, error) {
+	values := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		ContentType:      "application/x-www-form-urlencoded",
+		Uri: jamf.Uri{
+			Entity: oauthTokenPath,
+		},
+		Body: bytes.NewBufferString(values.Encode()),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var oauthToken OAuthToken
+	if err := json.Unmarshal(respBody, &oauthToken); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &oauthToken, resp, nil
+}
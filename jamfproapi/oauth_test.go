@@ -0,0 +1,52 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOAuthService_Token(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(oauthTokenPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {"dummyClientID"},
+			"client_secret": {"dummyClientSecret"},
+		}.Encode()))
+
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("Header.Get(Content-Type) returned %q, want \"application/x-www-form-urlencoded\"", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"access_token": "eyJhbGciOiJIUzI1NiJ9",
+			"scope": "api-role:1",
+			"token_type": "Bearer",
+			"expires_in": 299
+		}`)))
+	})
+
+	ctx := context.Background()
+	oauthToken, _, err := client.OAuth.Token(ctx, "dummyClientID", "dummyClientSecret")
+	if err != nil {
+		t.Fatalf("OAuth.Token(): %v", err)
+	}
+
+	want := &OAuthToken{
+		AccessToken: ptr("eyJhbGciOiJIUzI1NiJ9"),
+		Scope:       ptr("api-role:1"),
+		TokenType:   ptr("Bearer"),
+		ExpiresIn:   ptr(299),
+	}
+	if !cmp.Equal(oauthToken, want) {
+		t.Fatalf("OAuth.Token() returned %s, want %s", formatWithSpew(oauthToken), formatWithSpew(want))
+	}
+}
@@ -0,0 +1,558 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type PackagesService service
+
+type PackageHashType string
+
+const (
+	PackageHashTypeMD5    PackageHashType = jamf.HashTypeMD5
+	PackageHashTypeSHA512 PackageHashType = jamf.HashTypeSHA512
+)
+
+type Package struct {
+	ID                   *string          `json:"id,omitempty"`
+	PackageName          *string          `json:"packageName,omitempty"`
+	FileName             *string          `json:"fileName,omitempty"`
+	CategoryID           *string          `json:"categoryId,omitempty"`
+	Info                 *string          `json:"info,omitempty"`
+	Notes                *string          `json:"notes,omitempty"`
+	Priority             *int             `json:"priority,omitempty"`
+	OSRequirements       *string          `json:"osRequirements,omitempty"`
+	FillUserTemplate     *bool            `json:"fillUserTemplate,omitempty"`
+	Indexed              *bool            `json:"indexed,omitempty"`
+	FillExistingUsers    *bool            `json:"fillExistingUsers,omitempty"`
+	SWU                  *bool            `json:"swu,omitempty"`
+	RebootRequired       *bool            `json:"rebootRequired,omitempty"`
+	SelfHealNotify       *bool            `json:"selfHealNotify,omitempty"`
+	SelfHealingAction    *string          `json:"selfHealingAction,omitempty"`
+	OSInstall            *bool            `json:"osInstall,omitempty"`
+	SerialNumber         *string          `json:"serialNumber,omitempty"`
+	ParentPackageID      *string          `json:"parentPackageId,omitempty"`
+	BasePath             *string          `json:"basePath,omitempty"`
+	SuppressUpdates      *bool            `json:"suppressUpdates,omitempty"`
+	CloudTransferStatus  *string          `json:"cloudTransferStatus,omitempty"`
+	IgnoreConflicts      *bool            `json:"ignoreConflicts,omitempty"`
+	SuppressFromDock     *bool            `json:"suppressFromDock,omitempty"`
+	SuppressEula         *bool            `json:"suppressEula,omitempty"`
+	SuppressRegistration *bool            `json:"suppressRegistration,omitempty"`
+	InstallLanguage      *string          `json:"installLanguage,omitempty"`
+	MD5                  *string          `json:"md5,omitempty"`
+	SHA256               *string          `json:"sha256,omitempty"`
+	HashType             *PackageHashType `json:"hashType,omitempty"`
+	HashValue            *string          `json:"hashValue,omitempty"`
+	Size                 *string          `json:"size,omitempty"`
+	OSInstallerVersion   *string          `json:"osInstallerVersion,omitempty"`
+	Manifest             *string          `json:"manifest,omitempty"`
+	ManifestFileName     *string          `json:"manifestFileName,omitempty"`
+	Format               *string          `json:"format,omitempty"`
+}
+
+// SetChecksum sets the MD5 and SHA-512 of the checksum as the hashes of the package, so that Jamf Pro can verify it on devices.
+func (p *Package) SetChecksum(checksum *jamf.Checksum) {
+	hashType := PackageHashTypeSHA512
+	hashValue := checksum.SHA512
+	md5 := checksum.MD5
+	p.HashType = &hashType
+	p.HashValue = &hashValue
+	p.MD5 = &md5
+}
+
+type ListPackage struct {
+	TotalCount *int       `json:"totalCount,omitempty"`
+	Packages   *[]Package `json:"results,omitempty"`
+}
+
+const packagesPath = "/v1/packages"
+
+func (s *PackagesService) Create(ctx context.Context, pkg *Package) (*string, *jamf.Response, error) {
+	if pkg.PackageName == nil {
+		return nil, nil, errors.New("PackagesService.Create(): cannot create package with nil PackageName")
+	}
+	if pkg.FileName == nil {
+		return nil, nil, errors.New("PackagesService.Create(): cannot create package with nil FileName")
+	}
+	if pkg.CategoryID == nil {
+		return nil, nil, errors.New("PackagesService.Create(): cannot create package with nil CategoryID")
+	}
+	if pkg.Priority == nil {
+		return nil, nil, errors.New("PackagesService.Create(): cannot create package with nil Priority")
+	}
+
+	body, err := json.Marshal(pkg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: packagesPath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		ID   string `json:"id"`
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &data.ID, resp, nil
+}
+
+func (s *PackagesService) Delete(ctx context.Context, packageID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, packageID),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *PackagesService) DeleteMultiple(ctx context.Context, packageIDs []string) (*jamf.Response, error) {
+	var data struct {
+		PackageIDs []string `json:"ids"`
+	}
+	data.PackageIDs = packageIDs
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, "delete-multiple"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// Export returns the packages as CSV.
+func (s *PackagesService) Export(ctx context.Context, options ExportOptions) ([]byte, *jamf.Response, error) {
+	body, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, "export"),
+		},
+		RequestMiddlewareFunc: func(r *http.Request) {
+			r.Header.Set("Accept", "text/csv")
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	return respBody, resp, nil
+}
+
+func (s *PackagesService) Get(ctx context.Context, packageID string) (*Package, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, packageID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var pkg Package
+	if err := json.Unmarshal(respBody, &pkg); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &pkg, resp, nil
+}
+
+func (s *PackagesService) List(ctx context.Context, options ListOptions) (*ListPackage, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: packagesPath,
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listPackage ListPackage
+	if err := json.Unmarshal(respBody, &listPackage); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listPackage, resp, nil
+}
+
+// ListAll fetches all pages of the list.
+func (s *PackagesService) ListAll(ctx context.Context, options ListAllOptions) ([]Package, error) {
+	return ListAll(ctx, s.listPage, options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *PackagesService) ListPager(options ListOptions) *Pager[Package] {
+	return NewPager(s.listPage, options)
+}
+
+func (s *PackagesService) listPage(ctx context.Context, options ListOptions) ([]Package, int, error) {
+	list, _, err := s.List(ctx, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, totalCount := pageResults(list.Packages, list.TotalCount)
+	return results, totalCount, nil
+}
+
+func (s *PackagesService) ListHistory(ctx context.Context, packageID string, options ListOptions) (*ListObjectHistory, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, packageID, "history"),
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listHistory ListObjectHistory
+	if err := json.Unmarshal(respBody, &listHistory); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listHistory, resp, nil
+}
+
+func (s *PackagesService) CreateHistoryNote(ctx context.Context, packageID string, note string) (*string, *jamf.Response, error) {
+	body, err := json.Marshal(ObjectHistoryNote{Note: &note})
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, packageID, "history"),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		ID   string `json:"id"`
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &data.ID, resp, nil
+}
+
+func (s *PackagesService) Update(ctx context.Context, pkg *Package) (*Package, *jamf.Response, error) {
+	if pkg.ID == nil {
+		return nil, nil, errors.New("PackagesService.Update(): cannot update package with nil ID")
+	}
+	if pkg.PackageName == nil {
+		return nil, nil, errors.New("PackagesService.Update(): cannot update package with nil PackageName")
+	}
+	if pkg.FileName == nil {
+		return nil, nil, errors.New("PackagesService.Update(): cannot update package with nil FileName")
+	}
+
+	body, err := json.Marshal(pkg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, *pkg.ID),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Put(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newPackage Package
+	if err := json.Unmarshal(respBody, &newPackage); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newPackage, resp, nil
+}
+
+// VerifyChecksum compares the checksum of a local file with the hashes of the package on the server.
+// It returns jamf.ErrChecksumNotFound when the package has no hash, and a *jamf.ChecksumMismatchError when they differ.
+func (s *PackagesService) VerifyChecksum(ctx context.Context, packageID string, checksum *jamf.Checksum) (*jamf.Response, error) {
+	pkg, resp, err := s.Get(ctx, packageID)
+	if err != nil {
+		return resp, err
+	}
+
+	verified := false
+	if pkg.HashType != nil && pkg.HashValue != nil && *pkg.HashValue != "" {
+		if err := checksum.Verify(string(*pkg.HashType), *pkg.HashValue); err != nil {
+			return resp, fmt.Errorf("PackagesService.VerifyChecksum(): %w", err)
+		}
+		verified = true
+	}
+	if pkg.MD5 != nil && *pkg.MD5 != "" {
+		if err := checksum.Verify(jamf.HashTypeMD5, *pkg.MD5); err != nil {
+			return resp, fmt.Errorf("PackagesService.VerifyChecksum(): %w", err)
+		}
+		verified = true
+	}
+	if !verified {
+		return resp, fmt.Errorf("PackagesService.VerifyChecksum(): %w", jamf.ErrChecksumNotFound)
+	}
+
+	return resp, nil
+}
+
+// Upload uploads the file of a package, which is streamed as multipart/form-data without reading it into memory.
+// The upload can be retried only when src is an io.Seeker, e.g. *os.File.
+func (s *PackagesService) Upload(ctx context.Context, packageID string, fileName string, src io.Reader) (*jamf.Response, error) {
+	return s.UploadWithProgress(ctx, packageID, fileName, src, nil)
+}
+
+// UploadWithProgress is Upload reporting the progress of the upload to progress.
+// When ctx is canceled, the upload is aborted and the returned error satisfies errors.Is(err, context.Canceled).
+func (s *PackagesService) UploadWithProgress(ctx context.Context, packageID string, fileName string, src io.Reader, progress jamf.ProgressFunc) (*jamf.Response, error) {
+	body, err := newMultipartFileBody("file", fileName, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.upload(ctx, packageID, body, progress)
+}
+
+// UploadWithChecksum uploads the file of a package computing its checksum while it is streamed,
+// and then updates the package with the hashes. The package must have ID and FileName, and is replaced by the update.
+func (s *PackagesService) UploadWithChecksum(ctx context.Context, pkg *Package, src io.Reader, progress jamf.ProgressFunc) (*Package, *jamf.Response, error) {
+	if pkg.ID == nil {
+		return nil, nil, errors.New("PackagesService.UploadWithChecksum(): cannot upload package with nil ID")
+	}
+	if pkg.FileName == nil {
+		return nil, nil, errors.New("PackagesService.UploadWithChecksum(): cannot upload package with nil FileName")
+	}
+
+	body, err := newMultipartFileBody("file", *pkg.FileName, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	body.checksum = jamf.NewChecksumReader(src)
+
+	if resp, err := s.upload(ctx, *pkg.ID, body, progress); err != nil {
+		return nil, resp, err
+	}
+
+	pkg.SetChecksum(body.checksum.Checksum())
+
+	return s.Update(ctx, pkg)
+}
+
+func (s *PackagesService) upload(ctx context.Context, packageID string, body *multipartFileBody, progress jamf.ProgressFunc) (*jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: path.Join(packagesPath, packageID, "upload"),
+		},
+		ContentType:   body.contentType,
+		Body:          body.reader(),
+		ContentLength: body.length(),
+		GetBody:       body.getBody(),
+		Progress:      progress,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// multipartFileBody is a multipart/form-data body with a file, which streams the file between the header and the trailer.
+type multipartFileBody struct {
+	contentType string
+	header      []byte
+	trailer     []byte
+	src         io.Reader
+	srcLength   int64
+	seeker      io.Seeker
+	offset      int64
+	// checksum computes the checksum of the file while it is streamed, when it is set.
+	checksum *jamf.ChecksumReader
+}
+
+func newMultipartFileBody(fieldName string, fileName string, src io.Reader) (*multipartFileBody, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	if _, err := writer.CreateFormFile(fieldName, fileName); err != nil {
+		return nil, fmt.Errorf("writer.CreateFormFile(): %w", err)
+	}
+	header := append([]byte{}, buf.Bytes()...)
+	buf.Reset()
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("writer.Close(): %w", err)
+	}
+
+	body := &multipartFileBody{
+		contentType: writer.FormDataContentType(),
+		header:      header,
+		trailer:     append([]byte{}, buf.Bytes()...),
+		src:         src,
+		srcLength:   -1,
+	}
+	if s, ok := src.(io.Seeker); ok {
+		if offset, err := s.Seek(0, io.SeekCurrent); err == nil {
+			body.seeker = s
+			body.offset = offset
+			if end, err := s.Seek(0, io.SeekEnd); err == nil {
+				body.srcLength = end - offset
+			}
+			if _, err := s.Seek(offset, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("io.Seeker.Seek(): %w", err)
+			}
+		}
+	}
+
+	return body, nil
+}
+
+func (b *multipartFileBody) reader() io.Reader {
+	var src io.Reader = b.src
+	if b.checksum != nil {
+		src = b.checksum
+	}
+	return io.MultiReader(bytes.NewReader(b.header), src, bytes.NewReader(b.trailer))
+}
+
+// length returns the length of the whole body, or 0 when the length of the file is unknown.
+func (b *multipartFileBody) length() int64 {
+	if b.srcLength < 0 {
+		return 0
+	}
+	return int64(len(b.header)) + b.srcLength + int64(len(b.trailer))
+}
+
+// getBody rewinds the file to read the body again, when the file is an io.Seeker.
+func (b *multipartFileBody) getBody() jamf.GetBodyFunc {
+	if b.seeker == nil {
+		return nil
+	}
+	return func() (io.ReadCloser, error) {
+		if _, err := b.seeker.Seek(b.offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("io.Seeker.Seek(): %w", err)
+		}
+		if b.checksum != nil {
+			b.checksum.Reset()
+		}
+		return io.NopCloser(b.reader()), nil
+	}
+}
@@ -0,0 +1,442 @@
+package jamfproapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+)
+
+func TestPackagesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(packagesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"packageName":"test","fileName":"test.pkg","categoryId":"-1","priority":10}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v1/packages/1"
+		}`)))
+	})
+
+	ctx := context.Background()
+	packageID, _, err := client.Packages.Create(ctx, &Package{
+		PackageName: ptr("test"),
+		FileName:    ptr("test.pkg"),
+		CategoryID:  ptr("-1"),
+		Priority:    ptr(10),
+	})
+	if err != nil {
+		t.Fatalf("Packages.Create(): %v", err)
+	}
+
+	if want := "1"; packageID == nil || *packageID != want {
+		t.Fatalf("Packages.Create() returned %s, want %s", formatWithSpew(packageID), formatWithSpew(want))
+	}
+}
+
+func TestPackagesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.Delete(ctx, packageID)
+	if err != nil {
+		t.Fatalf("Packages.Delete(): %v", err)
+	}
+}
+
+func TestPackagesService_DeleteMultiple(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(packagesPath, "delete-multiple"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"ids":["1","2"]}`))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.DeleteMultiple(ctx, []string{"1", "2"})
+	if err != nil {
+		t.Fatalf("Packages.DeleteMultiple(): %v", err)
+	}
+}
+
+func TestPackagesService_Export(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(packagesPath, "export"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"filter":"packageName==\"test\"","fields":[{"fieldName":"packageName"}]}`))
+		if got, want := r.Header.Get("Accept"), "text/csv"; got != want {
+			t.Errorf("Header.Get(Accept) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("packageName\ntest\n"))
+	})
+
+	ctx := context.Background()
+	csv, _, err := client.Packages.Export(ctx, ExportOptions{
+		Filter: ptr(`packageName=="test"`),
+		Fields: &[]ExportField{
+			{FieldName: ptr("packageName")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Packages.Export(): %v", err)
+	}
+
+	if want := "packageName\ntest\n"; string(csv) != want {
+		t.Errorf("Packages.Export() returned %q, want %q", csv, want)
+	}
+}
+
+func TestPackagesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"packageName": "test",
+			"fileName": "test.pkg",
+			"categoryId": "-1",
+			"priority": 10,
+			"fillUserTemplate": false,
+			"rebootRequired": true,
+			"osInstall": false,
+			"suppressUpdates": false,
+			"hashType": "SHA_512",
+			"hashValue": "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e",
+			"md5": "d41d8cd98f00b204e9800998ecf8427e",
+			"size": "0"
+		}`)))
+	})
+
+	ctx := context.Background()
+	pkg, _, err := client.Packages.Get(ctx, packageID)
+	if err != nil {
+		t.Fatalf("Packages.Get(): %v", err)
+	}
+
+	want := &Package{
+		ID:               ptr("1"),
+		PackageName:      ptr("test"),
+		FileName:         ptr("test.pkg"),
+		CategoryID:       ptr("-1"),
+		Priority:         ptr(10),
+		FillUserTemplate: ptr(false),
+		RebootRequired:   ptr(true),
+		OSInstall:        ptr(false),
+		SuppressUpdates:  ptr(false),
+		HashType:         ptr(PackageHashTypeSHA512),
+		HashValue:        ptr("cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"),
+		MD5:              ptr("d41d8cd98f00b204e9800998ecf8427e"),
+		Size:             ptr("0"),
+	}
+	if !cmp.Equal(pkg, want) {
+		t.Fatalf("Packages.Get() returned %s, want %s", formatWithSpew(pkg), formatWithSpew(want))
+	}
+}
+
+func TestPackagesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(packagesPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `packageName=="test"`; got != want {
+			t.Errorf("Query().Get(filter) returned %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": "1",
+					"packageName": "test",
+					"fileName": "test.pkg"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	list, _, err := client.Packages.List(ctx, ListOptions{Filter: ptr(`packageName=="test"`)})
+	if err != nil {
+		t.Fatalf("Packages.List(): %v", err)
+	}
+
+	wantPackages := &[]Package{
+		{
+			ID:          ptr("1"),
+			PackageName: ptr("test"),
+			FileName:    ptr("test.pkg"),
+		},
+	}
+	if !cmp.Equal(list.Packages, wantPackages) {
+		t.Errorf("Packages.List() returned %s, want %s", formatWithSpew(list.Packages), formatWithSpew(wantPackages))
+	}
+
+	if wantTotalCount := 1; *list.TotalCount != wantTotalCount {
+		t.Errorf("Packages.List() returned %s, want %s", formatWithSpew(list.TotalCount), formatWithSpew(wantTotalCount))
+	}
+}
+
+func TestPackagesService_ListHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID, "history"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"id": 1,
+					"username": "admin",
+					"date": "2019-02-04T21:09:31.661Z",
+					"note": "Sso settings update",
+					"details": "Is SSO Enabled false"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	list, _, err := client.Packages.ListHistory(ctx, packageID, ListOptions{})
+	if err != nil {
+		t.Fatalf("Packages.ListHistory(): %v", err)
+	}
+
+	wantHistories := &[]ObjectHistory{
+		{
+			ID:       ptr(1),
+			Username: ptr("admin"),
+			Date:     ptr("2019-02-04T21:09:31.661Z"),
+			Note:     ptr("Sso settings update"),
+			Details:  ptr("Is SSO Enabled false"),
+		},
+	}
+	if !cmp.Equal(list.Histories, wantHistories) {
+		t.Errorf("Packages.ListHistory() returned %s, want %s", formatWithSpew(list.Histories), formatWithSpew(wantHistories))
+	}
+}
+
+func TestPackagesService_CreateHistoryNote(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID, "history"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"note":"test note"}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v1/packages/1/history/1"
+		}`)))
+	})
+
+	ctx := context.Background()
+	historyID, _, err := client.Packages.CreateHistoryNote(ctx, packageID, "test note")
+	if err != nil {
+		t.Fatalf("Packages.CreateHistoryNote(): %v", err)
+	}
+
+	if want := "1"; historyID == nil || *historyID != want {
+		t.Fatalf("Packages.CreateHistoryNote() returned %s, want %s", formatWithSpew(historyID), formatWithSpew(want))
+	}
+}
+
+func TestPackagesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"packageName": "test updated",
+			"fileName": "test.pkg"
+		}`)))
+	})
+
+	ctx := context.Background()
+	pkg, _, err := client.Packages.Update(ctx, &Package{
+		ID:          ptr(packageID),
+		PackageName: ptr("test updated"),
+		FileName:    ptr("test.pkg"),
+	})
+	if err != nil {
+		t.Fatalf("Packages.Update(): %v", err)
+	}
+
+	want := &Package{
+		ID:          ptr("1"),
+		PackageName: ptr("test updated"),
+		FileName:    ptr("test.pkg"),
+	}
+	if !cmp.Equal(pkg, want) {
+		t.Fatalf("Packages.Update() returned %s, want %s", formatWithSpew(pkg), formatWithSpew(want))
+	}
+}
+
+func TestPackagesService_Upload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+	content := "package content"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID, "upload"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if r.ContentLength <= int64(len(content)) {
+			t.Errorf("ContentLength is %d, want the length of the multipart body", r.ContentLength)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Request.FormFile(): %v", err)
+		}
+		defer file.Close()
+		if want := "test.pkg"; header.Filename != want {
+			t.Errorf("FileHeader.Filename is %q, want %q", header.Filename, want)
+		}
+		b, _ := io.ReadAll(file)
+		if string(b) != content {
+			t.Errorf("file content is %q, want %q", b, content)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v1/packages/1"
+		}`)))
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.Upload(ctx, packageID, "test.pkg", strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("Packages.Upload(): %v", err)
+	}
+}
+
+func TestPackagesService_UploadWithChecksum(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID, "upload"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v1/packages/1"
+		}`)))
+	})
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, []byte(`{"id":"1","packageName":"test","fileName":"test.pkg","md5":"900150983cd24fb0d6963f7d28e17f72","hashType":"SHA_512","hashValue":"ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"packageName": "test",
+			"fileName": "test.pkg",
+			"md5": "900150983cd24fb0d6963f7d28e17f72",
+			"hashType": "SHA_512",
+			"hashValue": "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"
+		}`)))
+	})
+
+	ctx := context.Background()
+	pkg, _, err := client.Packages.UploadWithChecksum(ctx, &Package{
+		ID:          ptr(packageID),
+		PackageName: ptr("test"),
+		FileName:    ptr("test.pkg"),
+	}, strings.NewReader("abc"), nil)
+	if err != nil {
+		t.Fatalf("Packages.UploadWithChecksum(): %v", err)
+	}
+
+	if want := PackageHashTypeSHA512; pkg.HashType == nil || *pkg.HashType != want {
+		t.Errorf("Packages.UploadWithChecksum() returned HashType %s, want %s", formatWithSpew(pkg.HashType), formatWithSpew(want))
+	}
+}
+
+func TestPackagesService_VerifyChecksum(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	packageID := "1"
+
+	mux.HandleFunc(buildHandlePath(packagesPath, packageID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"packageName": "test",
+			"fileName": "test.pkg",
+			"md5": "900150983cd24fb0d6963f7d28e17f72",
+			"hashType": "SHA_512",
+			"hashValue": "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"
+		}`)))
+	})
+
+	checksum, err := jamf.ComputeChecksum(strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("jamf.ComputeChecksum(): %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Packages.VerifyChecksum(ctx, packageID, checksum); err != nil {
+		t.Errorf("Packages.VerifyChecksum(): %v", err)
+	}
+
+	checksum.SHA512 = strings.Repeat("0", 128)
+	_, err = client.Packages.VerifyChecksum(ctx, packageID, checksum)
+	var mismatchErr *jamf.ChecksumMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Errorf("Packages.VerifyChecksum() returned %v, want *jamf.ChecksumMismatchError", err)
+	}
+}
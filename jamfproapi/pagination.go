@@ -0,0 +1,178 @@
+package jamfproapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const (
+	// DefaultPageSize is the page size used to walk pages when ListOptions.PageSize is nil.
+	DefaultPageSize = 100
+)
+
+// ListPageFunc fetches a page of a paged list endpoint and returns its results and the total count of all pages.
+type ListPageFunc[T any] func(ctx context.Context, options ListOptions) ([]T, int, error)
+
+// ListAllOptions configures the walk over all pages.
+type ListAllOptions struct {
+	ListOptions
+	// Concurrency is the number of pages fetched in parallel once the total count is known.
+	// The pages are fetched one by one when it is less than 2.
+	Concurrency int
+}
+
+// Pager walks the pages of a paged list endpoint one by one.
+type Pager[T any] struct {
+	fetch    ListPageFunc[T]
+	options  ListOptions
+	page     int
+	pageSize int
+	fetched  int
+	done     bool
+}
+
+// NewPager returns a Pager which starts from the page of the options.
+func NewPager[T any](fetch ListPageFunc[T], options ListOptions) *Pager[T] {
+	page := 0
+	if options.Page != nil {
+		page = *options.Page
+	}
+	pageSize := DefaultPageSize
+	if options.PageSize != nil && *options.PageSize > 0 {
+		pageSize = *options.PageSize
+	}
+
+	return &Pager[T]{
+		fetch:    fetch,
+		options:  options,
+		page:     page,
+		pageSize: pageSize,
+	}
+}
+
+// HasNext reports whether there may be more pages.
+func (p *Pager[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	results, totalCount, err := p.fetch(ctx, p.options.withPage(p.page, p.pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	p.page++
+	p.fetched += len(results)
+	if len(results) < p.pageSize || p.fetched >= totalCount-p.offset() {
+		p.done = true
+	}
+
+	return results, nil
+}
+
+// offset returns the number of results before the first page.
+func (p *Pager[T]) offset() int {
+	if p.options.Page == nil {
+		return 0
+	}
+	return *p.options.Page * p.pageSize
+}
+
+// ListAll fetches all pages and returns their results in order.
+func ListAll[T any](ctx context.Context, fetch ListPageFunc[T], options ListAllOptions) ([]T, error) {
+	pager := NewPager(fetch, options.ListOptions)
+	if options.Concurrency < 2 {
+		var all []T
+		for pager.HasNext() {
+			results, err := pager.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, results...)
+		}
+		return all, nil
+	}
+
+	// The total count is known after the first page is fetched.
+	firstPage := pager.page
+	first, totalCount, err := fetch(ctx, options.withPage(firstPage, pager.pageSize))
+	if err != nil {
+		return nil, err
+	}
+	remaining := totalCount - pager.offset() - len(first)
+	if len(first) < pager.pageSize || remaining <= 0 {
+		return first, nil
+	}
+
+	pageCount := (remaining + pager.pageSize - 1) / pager.pageSize
+	pages := make([][]T, pageCount)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, options.Concurrency)
+	launched := 0
+	for ; launched < pageCount && ctx.Err() == nil; launched++ {
+		i := launched
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results, _, err := fetch(ctx, options.withPage(firstPage+1+i, pager.pageSize))
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			pages[i] = results
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	// ctx is done before every page is launched, while the pages already launched may succeed.
+	if launched < pageCount {
+		return nil, fmt.Errorf("ListAll(): page %d is not fetched: %w", firstPage+1+launched, ctx.Err())
+	}
+
+	all := first
+	for _, results := range pages {
+		all = append(all, results...)
+	}
+
+	return all, nil
+}
+
+func (o ListOptions) withPage(page int, pageSize int) ListOptions {
+	o.Page = &page
+	o.PageSize = &pageSize
+	return o
+}
+
+// pageResults dereferences the results and the total count of a page.
+func pageResults[T any](results *[]T, totalCount *int) ([]T, int) {
+	var r []T
+	if results != nil {
+		r = *results
+	}
+	var n int
+	if totalCount != nil {
+		n = *totalCount
+	}
+	return r, n
+}
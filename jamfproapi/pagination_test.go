@@ -0,0 +1,129 @@
+package jamfproapi
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func fakeListPageFunc(totalCount int, calls *int32) ListPageFunc[int] {
+	return func(_ context.Context, options ListOptions) ([]int, int, error) {
+		atomic.AddInt32(calls, 1)
+
+		var results []int
+		for i := *options.Page * *options.PageSize; i < (*options.Page+1)**options.PageSize && i < totalCount; i++ {
+			results = append(results, i)
+		}
+		return results, totalCount, nil
+	}
+}
+
+func TestListAll(t *testing.T) {
+	cases := []struct {
+		name        string
+		totalCount  int
+		page        *int
+		pageSize    *int
+		concurrency int
+		want        []int
+		wantCalls   int32
+	}{
+		{name: "empty", totalCount: 0, pageSize: ptr(2), wantCalls: 1},
+		{name: "exact pages", totalCount: 4, pageSize: ptr(2), want: []int{0, 1, 2, 3}, wantCalls: 2},
+		{name: "partial last page", totalCount: 5, pageSize: ptr(2), want: []int{0, 1, 2, 3, 4}, wantCalls: 3},
+		{name: "from page", totalCount: 5, page: ptr(1), pageSize: ptr(2), want: []int{2, 3, 4}, wantCalls: 2},
+		{name: "default page size", totalCount: 150, want: seq(150), wantCalls: 2},
+		{name: "parallel", totalCount: 9, pageSize: ptr(2), concurrency: 3, want: seq(9), wantCalls: 5},
+		{name: "parallel single page", totalCount: 1, pageSize: ptr(2), concurrency: 3, want: []int{0}, wantCalls: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			got, err := ListAll(context.Background(), fakeListPageFunc(tc.totalCount, &calls), ListAllOptions{
+				ListOptions: ListOptions{Page: tc.page, PageSize: tc.pageSize},
+				Concurrency: tc.concurrency,
+			})
+			if err != nil {
+				t.Fatalf("ListAll(): %v", err)
+			}
+
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("ListAll() returned %v, want %v", got, tc.want)
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("ListAll() fetched %d pages, want %d", calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestListAll_Error(t *testing.T) {
+	wantErr := errors.New("failed")
+	fetch := func(_ context.Context, options ListOptions) ([]int, int, error) {
+		if *options.Page == 2 {
+			return nil, 0, wantErr
+		}
+		return []int{0, 1}, 10, nil
+	}
+
+	for _, concurrency := range []int{0, 3} {
+		_, err := ListAll(context.Background(), fetch, ListAllOptions{
+			ListOptions: ListOptions{PageSize: ptr(2)},
+			Concurrency: concurrency,
+		})
+		if !errors.Is(err, wantErr) {
+			t.Errorf("ListAll() with concurrency %d returned %v, want %v", concurrency, err, wantErr)
+		}
+	}
+}
+
+func TestListAll_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The fetches ignore ctx, so the pages launched before the cancellation succeed.
+	fetch := func(_ context.Context, options ListOptions) ([]int, int, error) {
+		if *options.Page == 1 {
+			cancel()
+		}
+		return []int{0}, 10, nil
+	}
+
+	got, err := ListAll(ctx, fetch, ListAllOptions{
+		ListOptions: ListOptions{PageSize: ptr(1)},
+		Concurrency: 2,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ListAll() returned %v and %d results, want %v", err, len(got), context.Canceled)
+	}
+}
+
+func TestPager(t *testing.T) {
+	var calls int32
+	pager := NewPager(fakeListPageFunc(3, &calls), ListOptions{PageSize: ptr(2)})
+
+	var pages [][]int
+	for pager.HasNext() {
+		results, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Pager.Next(): %v", err)
+		}
+		pages = append(pages, results)
+	}
+
+	if want := [][]int{{0, 1}, {2}}; !cmp.Equal(pages, want) {
+		t.Errorf("Pager.Next() returned %v, want %v", pages, want)
+	}
+}
+
+func seq(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
@@ -0,0 +1,498 @@
+package jamfproapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/google/go-querystring/query"
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+	"github.com/kenchan0130/go-jamf-pro/utils"
+)
+
+type PatchSoftwareTitleConfigurationsService service
+
+type PatchSoftwareTitleConfiguration struct {
+	ID                     *string                                              `json:"id,omitempty"`
+	DisplayName            *string                                              `json:"displayName,omitempty"`
+	CategoryID             *string                                              `json:"categoryId,omitempty"`
+	SiteID                 *string                                              `json:"siteId,omitempty"`
+	UINotifications        *bool                                                `json:"uiNotifications,omitempty"`
+	EmailNotifications     *bool                                                `json:"emailNotifications,omitempty"`
+	SoftwareTitleID        *string                                              `json:"softwareTitleId,omitempty"`
+	ExtensionAttributes    *[]PatchSoftwareTitleConfigurationExtensionAttribute `json:"extensionAttributes,omitempty"`
+	JamfOfficial           *bool                                                `json:"jamfOfficial,omitempty"`
+	SoftwareTitleName      *string                                              `json:"softwareTitleName,omitempty"`
+	SoftwareTitleNameID    *string                                              `json:"softwareTitleNameId,omitempty"`
+	SoftwareTitlePublisher *string                                              `json:"softwareTitlePublisher,omitempty"`
+	PatchSourceName        *string                                              `json:"patchSourceName,omitempty"`
+	PatchSourceEnabled     *bool                                                `json:"patchSourceEnabled,omitempty"`
+	Packages               *[]PatchSoftwareTitleConfigurationPackage            `json:"packages,omitempty"`
+}
+
+// PatchSoftwareTitleConfigurationExtensionAttribute is the acceptance of an extension attribute which a software title requires.
+type PatchSoftwareTitleConfigurationExtensionAttribute struct {
+	Accepted *bool   `json:"accepted,omitempty"`
+	EAID     *string `json:"eaId,omitempty"`
+}
+
+// PatchSoftwareTitleConfigurationPackage maps a version of the software title to a package.
+type PatchSoftwareTitleConfigurationPackage struct {
+	PackageID   *string `json:"packageId,omitempty"`
+	Version     *string `json:"version,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// SetPackage maps the version to the package, replacing the package which is already mapped to the version.
+// It does nothing when pkg is nil.
+func (c *PatchSoftwareTitleConfiguration) SetPackage(version string, pkg *Package) {
+	if pkg == nil {
+		return
+	}
+
+	mapping := PatchSoftwareTitleConfigurationPackage{
+		PackageID:   pkg.ID,
+		Version:     &version,
+		DisplayName: pkg.PackageName,
+	}
+	if c.Packages == nil {
+		c.Packages = &[]PatchSoftwareTitleConfigurationPackage{}
+	}
+	for i, p := range *c.Packages {
+		if p.Version != nil && *p.Version == version {
+			(*c.Packages)[i] = mapping
+			return
+		}
+	}
+	*c.Packages = append(*c.Packages, mapping)
+}
+
+// PackageID returns the ID of the package which is mapped to the version, or nil when no package is mapped.
+func (c *PatchSoftwareTitleConfiguration) PackageID(version string) *string {
+	if c.Packages == nil {
+		return nil
+	}
+	for _, p := range *c.Packages {
+		if p.Version != nil && *p.Version == version {
+			return p.PackageID
+		}
+	}
+	return nil
+}
+
+// PatchSoftwareTitleDefinition is a version of the software title published by the patch source.
+type PatchSoftwareTitleDefinition struct {
+	Version                *string                                `json:"version,omitempty"`
+	MinimumOperatingSystem *string                                `json:"minimumOperatingSystem,omitempty"`
+	ReleaseDate            *string                                `json:"releaseDate,omitempty"`
+	RebootRequired         *bool                                  `json:"rebootRequired,omitempty"`
+	KillApps               *[]PatchSoftwareTitleDefinitionKillApp `json:"killApps,omitempty"`
+	Standalone             *bool                                  `json:"standalone,omitempty"`
+	AbsoluteOrderID        *string                                `json:"absoluteOrderId,omitempty"`
+}
+
+type PatchSoftwareTitleDefinitionKillApp struct {
+	AppName *string `json:"appName,omitempty"`
+}
+
+type ListPatchSoftwareTitleDefinition struct {
+	TotalCount  *int                            `json:"totalCount,omitempty"`
+	Definitions *[]PatchSoftwareTitleDefinition `json:"results,omitempty"`
+}
+
+type PatchSoftwareTitleConfigurationDashboard struct {
+	OnDashboard *bool `json:"onDashboard,omitempty"`
+}
+
+// PatchSoftwareTitleConfigurationReport is the patch status of a computer.
+type PatchSoftwareTitleConfigurationReport struct {
+	ComputerName           *string `json:"computerName,omitempty"`
+	DeviceID               *string `json:"deviceId,omitempty"`
+	Username               *string `json:"username,omitempty"`
+	OperatingSystemVersion *string `json:"operatingSystemVersion,omitempty"`
+	LastContactTime        *string `json:"lastContactTime,omitempty"`
+	BuildingName           *string `json:"buildingName,omitempty"`
+	DepartmentName         *string `json:"departmentName,omitempty"`
+	SiteName               *string `json:"siteName,omitempty"`
+	Version                *string `json:"version,omitempty"`
+}
+
+type ListPatchSoftwareTitleConfigurationReport struct {
+	TotalCount *int                                     `json:"totalCount,omitempty"`
+	Reports    *[]PatchSoftwareTitleConfigurationReport `json:"results,omitempty"`
+}
+
+type PatchSoftwareTitleConfigurationSummary struct {
+	SoftwareTitleID              *string `json:"softwareTitleId,omitempty"`
+	SoftwareTitleConfigurationID *string `json:"softwareTitleConfigurationId,omitempty"`
+	Title                        *string `json:"title,omitempty"`
+	LatestVersion                *string `json:"latestVersion,omitempty"`
+	ReleaseDate                  *string `json:"releaseDate,omitempty"`
+	UpToDate                     *int    `json:"upToDate,omitempty"`
+	OutOfDate                    *int    `json:"outOfDate,omitempty"`
+	OnDashboard                  *bool   `json:"onDashboard,omitempty"`
+}
+
+// PatchSoftwareTitleConfigurationSummaryVersion is the number of computers on a version.
+type PatchSoftwareTitleConfigurationSummaryVersion struct {
+	AbsoluteOrderID *string `json:"absoluteOrderId,omitempty"`
+	Version         *string `json:"version,omitempty"`
+	OnVersion       *int    `json:"onVersion,omitempty"`
+}
+
+const patchSoftwareTitleConfigurationsPath = "/v2/patch-software-title-configurations"
+
+// AddToDashboard shows the software title on the Patch Management dashboard.
+func (s *PatchSoftwareTitleConfigurationsService) AddToDashboard(ctx context.Context, configurationID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "dashboard"),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Post(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatchSoftwareTitleConfigurationsService) Create(ctx context.Context, configuration *PatchSoftwareTitleConfiguration) (*string, *jamf.Response, error) {
+	if configuration.DisplayName == nil {
+		return nil, nil, errors.New("PatchSoftwareTitleConfigurationsService.Create(): cannot create patch software title configuration with nil DisplayName")
+	}
+	if configuration.SoftwareTitleID == nil {
+		return nil, nil, errors.New("PatchSoftwareTitleConfigurationsService.Create(): cannot create patch software title configuration with nil SoftwareTitleID")
+	}
+
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: jamf.Uri{
+			Entity: patchSoftwareTitleConfigurationsPath,
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var data struct {
+		ID   string `json:"id"`
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &data.ID, resp, nil
+}
+
+func (s *PatchSoftwareTitleConfigurationsService) Delete(ctx context.Context, configurationID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatchSoftwareTitleConfigurationsService) Get(ctx context.Context, configurationID string) (*PatchSoftwareTitleConfiguration, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var configuration PatchSoftwareTitleConfiguration
+	if err := json.Unmarshal(respBody, &configuration); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &configuration, resp, nil
+}
+
+// GetDashboard returns whether the software title is shown on the Patch Management dashboard.
+func (s *PatchSoftwareTitleConfigurationsService) GetDashboard(ctx context.Context, configurationID string) (*PatchSoftwareTitleConfigurationDashboard, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "dashboard"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var dashboard PatchSoftwareTitleConfigurationDashboard
+	if err := json.Unmarshal(respBody, &dashboard); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &dashboard, resp, nil
+}
+
+// GetPatchSummary returns the number of computers which are up to date and out of date.
+func (s *PatchSoftwareTitleConfigurationsService) GetPatchSummary(ctx context.Context, configurationID string) (*PatchSoftwareTitleConfigurationSummary, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "patch-summary"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var summary PatchSoftwareTitleConfigurationSummary
+	if err := json.Unmarshal(respBody, &summary); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &summary, resp, nil
+}
+
+// List returns all the configurations. The API is not paged.
+func (s *PatchSoftwareTitleConfigurationsService) List(ctx context.Context) ([]PatchSoftwareTitleConfiguration, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: patchSoftwareTitleConfigurationsPath,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var configurations []PatchSoftwareTitleConfiguration
+	if err := json.Unmarshal(respBody, &configurations); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return configurations, resp, nil
+}
+
+// ListDefinitions returns the versions of the software title which are published by the patch source.
+func (s *PatchSoftwareTitleConfigurationsService) ListDefinitions(ctx context.Context, configurationID string, options ListOptions) (*ListPatchSoftwareTitleDefinition, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "definitions"),
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listDefinition ListPatchSoftwareTitleDefinition
+	if err := json.Unmarshal(respBody, &listDefinition); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listDefinition, resp, nil
+}
+
+// ListAllDefinitions fetches all pages of the definitions.
+func (s *PatchSoftwareTitleConfigurationsService) ListAllDefinitions(ctx context.Context, configurationID string, options ListAllOptions) ([]PatchSoftwareTitleDefinition, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]PatchSoftwareTitleDefinition, int, error) {
+		list, _, err := s.ListDefinitions(ctx, configurationID, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Definitions, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+// ListPatchReport returns the patch status of the computers which have the software title installed.
+func (s *PatchSoftwareTitleConfigurationsService) ListPatchReport(ctx context.Context, configurationID string, options ListOptions) (*ListPatchSoftwareTitleConfigurationReport, *jamf.Response, error) {
+	params, err := query.Values(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
+	}
+
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "patch-report"),
+			Params: params,
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var listReport ListPatchSoftwareTitleConfigurationReport
+	if err := json.Unmarshal(respBody, &listReport); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &listReport, resp, nil
+}
+
+// ListAllPatchReport fetches all pages of the patch report.
+func (s *PatchSoftwareTitleConfigurationsService) ListAllPatchReport(ctx context.Context, configurationID string, options ListAllOptions) ([]PatchSoftwareTitleConfigurationReport, error) {
+	return ListAll(ctx, func(ctx context.Context, options ListOptions) ([]PatchSoftwareTitleConfigurationReport, int, error) {
+		list, _, err := s.ListPatchReport(ctx, configurationID, options)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results, totalCount := pageResults(list.Reports, list.TotalCount)
+		return results, totalCount, nil
+	}, options)
+}
+
+// ListPatchSummaryVersions returns the number of computers on each version of the software title.
+func (s *PatchSoftwareTitleConfigurationsService) ListPatchSummaryVersions(ctx context.Context, configurationID string) ([]PatchSoftwareTitleConfigurationSummaryVersion, *jamf.Response, error) {
+	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "patch-summary", "versions"),
+		},
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var versions []PatchSoftwareTitleConfigurationSummaryVersion
+	if err := json.Unmarshal(respBody, &versions); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return versions, resp, nil
+}
+
+// RemoveFromDashboard hides the software title from the Patch Management dashboard.
+func (s *PatchSoftwareTitleConfigurationsService) RemoveFromDashboard(ctx context.Context, configurationID string) (*jamf.Response, error) {
+	resp, _, err := s.client.Delete(ctx, jamf.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID, "dashboard"),
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("client.Delete(): %w", err)
+	}
+
+	return resp, nil
+}
+
+// Update updates the configuration with JSON merge patch, so only the fields which are set are changed.
+// Note that Packages replaces all the packages which are mapped to versions.
+func (s *PatchSoftwareTitleConfigurationsService) Update(ctx context.Context, configurationID string, configuration *PatchSoftwareTitleConfiguration) (*PatchSoftwareTitleConfiguration, *jamf.Response, error) {
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
+	}
+
+	resp, _, err := s.client.Patch(ctx, jamf.PatchHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		ContentType:      "application/merge-patch+json",
+		Uri: jamf.Uri{
+			Entity: path.Join(patchSoftwareTitleConfigurationsPath, configurationID),
+		},
+		Body: bytes.NewBuffer(body),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("client.Patch(): %w", err)
+	}
+	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
+	}
+
+	var newConfiguration PatchSoftwareTitleConfiguration
+	if err := json.Unmarshal(respBody, &newConfiguration); err != nil {
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
+	}
+
+	return &newConfiguration, resp, nil
+}
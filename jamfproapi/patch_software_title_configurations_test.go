@@ -0,0 +1,478 @@
+package jamfproapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPatchSoftwareTitleConfiguration_SetPackage(t *testing.T) {
+	configuration := &PatchSoftwareTitleConfiguration{
+		Packages: &[]PatchSoftwareTitleConfigurationPackage{
+			{PackageID: ptr("1"), Version: ptr("123.0"), DisplayName: ptr("Chrome-123.0.pkg")},
+		},
+	}
+
+	configuration.SetPackage("124.0", &Package{ID: ptr("2"), PackageName: ptr("Chrome-124.0.pkg")})
+	configuration.SetPackage("123.0", &Package{ID: ptr("3"), PackageName: ptr("Chrome-123.0-fixed.pkg")})
+	configuration.SetPackage("125.0", nil)
+
+	want := &[]PatchSoftwareTitleConfigurationPackage{
+		{PackageID: ptr("3"), Version: ptr("123.0"), DisplayName: ptr("Chrome-123.0-fixed.pkg")},
+		{PackageID: ptr("2"), Version: ptr("124.0"), DisplayName: ptr("Chrome-124.0.pkg")},
+	}
+	if !cmp.Equal(configuration.Packages, want) {
+		t.Errorf("PatchSoftwareTitleConfiguration.SetPackage() set %s, want %s", formatWithSpew(configuration.Packages), formatWithSpew(want))
+	}
+
+	if got := configuration.PackageID("124.0"); !cmp.Equal(got, ptr("2")) {
+		t.Errorf("PatchSoftwareTitleConfiguration.PackageID() returned %s, want %s", formatWithSpew(got), formatWithSpew(ptr("2")))
+	}
+	if got := configuration.PackageID("125.0"); got != nil {
+		t.Errorf("PatchSoftwareTitleConfiguration.PackageID() returned %s, want nil", formatWithSpew(got))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_AddToDashboard(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "dashboard"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchSoftwareTitleConfigurations.AddToDashboard(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.AddToDashboard(): %v", err)
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"displayName":"Google Chrome","softwareTitleId":"5","packages":[{"packageId":"2","version":"124.0","displayName":"Chrome-124.0.pkg"}]}`))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"href": "https://yourJamfProUrl.jamf/api/v2/patch-software-title-configurations/1"
+		}`)))
+	})
+
+	configuration := &PatchSoftwareTitleConfiguration{
+		DisplayName:     ptr("Google Chrome"),
+		SoftwareTitleID: ptr("5"),
+	}
+	configuration.SetPackage("124.0", &Package{ID: ptr("2"), PackageName: ptr("Chrome-124.0.pkg")})
+
+	ctx := context.Background()
+	configurationID, _, err := client.PatchSoftwareTitleConfigurations.Create(ctx, configuration)
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.Create(): %v", err)
+	}
+
+	want := ptr("1")
+	if !cmp.Equal(configurationID, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.Create() returned %s, want %s", formatWithSpew(configurationID), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchSoftwareTitleConfigurations.Delete(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.Delete(): %v", err)
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"displayName": "Google Chrome",
+			"categoryId": "-1",
+			"siteId": "-1",
+			"uiNotifications": true,
+			"emailNotifications": false,
+			"softwareTitleId": "5",
+			"extensionAttributes": [
+				{
+					"accepted": true,
+					"eaId": "google-chrome-ea"
+				}
+			],
+			"jamfOfficial": true,
+			"softwareTitleName": "Google Chrome",
+			"softwareTitleNameId": "GoogleChrome",
+			"softwareTitlePublisher": "Google",
+			"patchSourceName": "Jamf",
+			"patchSourceEnabled": true,
+			"packages": [
+				{
+					"packageId": "2",
+					"version": "124.0",
+					"displayName": "Chrome-124.0.pkg"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	configuration, _, err := client.PatchSoftwareTitleConfigurations.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.Get(): %v", err)
+	}
+
+	want := &PatchSoftwareTitleConfiguration{
+		ID:                 ptr("1"),
+		DisplayName:        ptr("Google Chrome"),
+		CategoryID:         ptr("-1"),
+		SiteID:             ptr("-1"),
+		UINotifications:    ptr(true),
+		EmailNotifications: ptr(false),
+		SoftwareTitleID:    ptr("5"),
+		ExtensionAttributes: &[]PatchSoftwareTitleConfigurationExtensionAttribute{
+			{
+				Accepted: ptr(true),
+				EAID:     ptr("google-chrome-ea"),
+			},
+		},
+		JamfOfficial:           ptr(true),
+		SoftwareTitleName:      ptr("Google Chrome"),
+		SoftwareTitleNameID:    ptr("GoogleChrome"),
+		SoftwareTitlePublisher: ptr("Google"),
+		PatchSourceName:        ptr("Jamf"),
+		PatchSourceEnabled:     ptr(true),
+		Packages: &[]PatchSoftwareTitleConfigurationPackage{
+			{
+				PackageID:   ptr("2"),
+				Version:     ptr("124.0"),
+				DisplayName: ptr("Chrome-124.0.pkg"),
+			},
+		},
+	}
+	if !cmp.Equal(configuration, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.Get() returned %s, want %s", formatWithSpew(configuration), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_GetDashboard(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "dashboard"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{"onDashboard": true}`)))
+	})
+
+	ctx := context.Background()
+	dashboard, _, err := client.PatchSoftwareTitleConfigurations.GetDashboard(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.GetDashboard(): %v", err)
+	}
+
+	want := &PatchSoftwareTitleConfigurationDashboard{OnDashboard: ptr(true)}
+	if !cmp.Equal(dashboard, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.GetDashboard() returned %s, want %s", formatWithSpew(dashboard), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_GetPatchSummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "patch-summary"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"softwareTitleId": "5",
+			"softwareTitleConfigurationId": "1",
+			"title": "Google Chrome",
+			"latestVersion": "124.0",
+			"releaseDate": "2024-04-16T19:31:10Z",
+			"upToDate": 10,
+			"outOfDate": 3,
+			"onDashboard": true
+		}`)))
+	})
+
+	ctx := context.Background()
+	summary, _, err := client.PatchSoftwareTitleConfigurations.GetPatchSummary(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.GetPatchSummary(): %v", err)
+	}
+
+	want := &PatchSoftwareTitleConfigurationSummary{
+		SoftwareTitleID:              ptr("5"),
+		SoftwareTitleConfigurationID: ptr("1"),
+		Title:                        ptr("Google Chrome"),
+		LatestVersion:                ptr("124.0"),
+		ReleaseDate:                  ptr("2024-04-16T19:31:10Z"),
+		UpToDate:                     ptr(10),
+		OutOfDate:                    ptr(3),
+		OnDashboard:                  ptr(true),
+	}
+	if !cmp.Equal(summary, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.GetPatchSummary() returned %s, want %s", formatWithSpew(summary), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`[
+			{
+				"id": "1",
+				"displayName": "Google Chrome",
+				"softwareTitleId": "5"
+			}
+		]`)))
+	})
+
+	ctx := context.Background()
+	configurations, _, err := client.PatchSoftwareTitleConfigurations.List(ctx)
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.List(): %v", err)
+	}
+
+	want := []PatchSoftwareTitleConfiguration{
+		{
+			ID:              ptr("1"),
+			DisplayName:     ptr("Google Chrome"),
+			SoftwareTitleID: ptr("5"),
+		},
+	}
+	if !cmp.Equal(configurations, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.List() returned %s, want %s", formatWithSpew(configurations), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_ListAllDefinitions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "definitions"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "0":
+			_, _ = w.Write(compactJSON([]byte(`{
+				"totalCount": 2,
+				"results": [
+					{
+						"version": "124.0",
+						"minimumOperatingSystem": "10.15",
+						"releaseDate": "2024-04-16T19:31:10Z",
+						"rebootRequired": false,
+						"killApps": [{"appName": "Google Chrome.app"}],
+						"standalone": true,
+						"absoluteOrderId": "0"
+					}
+				]
+			}`)))
+		case "1":
+			_, _ = w.Write(compactJSON([]byte(`{"totalCount": 2, "results": [{"version": "123.0"}]}`)))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ctx := context.Background()
+	definitions, err := client.PatchSoftwareTitleConfigurations.ListAllDefinitions(ctx, "1", ListAllOptions{
+		ListOptions: ListOptions{PageSize: ptr(1)},
+	})
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.ListAllDefinitions(): %v", err)
+	}
+
+	want := []PatchSoftwareTitleDefinition{
+		{
+			Version:                ptr("124.0"),
+			MinimumOperatingSystem: ptr("10.15"),
+			ReleaseDate:            ptr("2024-04-16T19:31:10Z"),
+			RebootRequired:         ptr(false),
+			KillApps: &[]PatchSoftwareTitleDefinitionKillApp{
+				{AppName: ptr("Google Chrome.app")},
+			},
+			Standalone:      ptr(true),
+			AbsoluteOrderID: ptr("0"),
+		},
+		{
+			Version: ptr("123.0"),
+		},
+	}
+	if !cmp.Equal(definitions, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.ListAllDefinitions() returned %s, want %s", formatWithSpew(definitions), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_ListPatchReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "patch-report"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("filter"), `version=="123.0"`; got != want {
+			t.Errorf("filter = %q, want %q", got, want)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"totalCount": 1,
+			"results": [
+				{
+					"computerName": "MacBook Pro",
+					"deviceId": "1",
+					"username": "user",
+					"operatingSystemVersion": "14.4.1",
+					"lastContactTime": "2024-04-17T09:00:00Z",
+					"buildingName": "HQ",
+					"departmentName": "IT",
+					"siteName": "None",
+					"version": "123.0"
+				}
+			]
+		}`)))
+	})
+
+	ctx := context.Background()
+	reports, _, err := client.PatchSoftwareTitleConfigurations.ListPatchReport(ctx, "1", ListOptions{
+		Filter: ptr(`version=="123.0"`),
+	})
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.ListPatchReport(): %v", err)
+	}
+
+	want := &ListPatchSoftwareTitleConfigurationReport{
+		TotalCount: ptr(1),
+		Reports: &[]PatchSoftwareTitleConfigurationReport{
+			{
+				ComputerName:           ptr("MacBook Pro"),
+				DeviceID:               ptr("1"),
+				Username:               ptr("user"),
+				OperatingSystemVersion: ptr("14.4.1"),
+				LastContactTime:        ptr("2024-04-17T09:00:00Z"),
+				BuildingName:           ptr("HQ"),
+				DepartmentName:         ptr("IT"),
+				SiteName:               ptr("None"),
+				Version:                ptr("123.0"),
+			},
+		},
+	}
+	if !cmp.Equal(reports, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.ListPatchReport() returned %s, want %s", formatWithSpew(reports), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_ListPatchSummaryVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "patch-summary", "versions"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`[
+			{"absoluteOrderId": "0", "version": "124.0", "onVersion": 10},
+			{"absoluteOrderId": "1", "version": "123.0", "onVersion": 3}
+		]`)))
+	})
+
+	ctx := context.Background()
+	versions, _, err := client.PatchSoftwareTitleConfigurations.ListPatchSummaryVersions(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.ListPatchSummaryVersions(): %v", err)
+	}
+
+	want := []PatchSoftwareTitleConfigurationSummaryVersion{
+		{AbsoluteOrderID: ptr("0"), Version: ptr("124.0"), OnVersion: ptr(10)},
+		{AbsoluteOrderID: ptr("1"), Version: ptr("123.0"), OnVersion: ptr(3)},
+	}
+	if !cmp.Equal(versions, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.ListPatchSummaryVersions() returned %s, want %s", formatWithSpew(versions), formatWithSpew(want))
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_RemoveFromDashboard(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1", "dashboard"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.PatchSoftwareTitleConfigurations.RemoveFromDashboard(ctx, "1")
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.RemoveFromDashboard(): %v", err)
+	}
+}
+
+func TestPatchSoftwareTitleConfigurationsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(patchSoftwareTitleConfigurationsPath, "1"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		if got, want := r.Header.Get("Content-Type"), "application/merge-patch+json"; got != want {
+			t.Errorf("Content-Type = %q, want %q", got, want)
+		}
+		testBody(t, r, []byte(`{"displayName":"Chrome"}`))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"id": "1",
+			"displayName": "Chrome"
+		}`)))
+	})
+
+	ctx := context.Background()
+	configuration, _, err := client.PatchSoftwareTitleConfigurations.Update(ctx, "1", &PatchSoftwareTitleConfiguration{
+		DisplayName: ptr("Chrome"),
+	})
+	if err != nil {
+		t.Fatalf("PatchSoftwareTitleConfigurations.Update(): %v", err)
+	}
+
+	want := &PatchSoftwareTitleConfiguration{
+		ID:          ptr("1"),
+		DisplayName: ptr("Chrome"),
+	}
+	if !cmp.Equal(configuration, want) {
+		t.Errorf("PatchSoftwareTitleConfigurations.Update() returned %s, want %s", formatWithSpew(configuration), formatWithSpew(want))
+	}
+}
@@ -0,0 +1,268 @@
+package jamfproapi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter is an RSQL expression which can be used as ListOptions.Filter through FilterValue.
+type Filter interface {
+	String() string
+	negate() Filter
+}
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorEqual              ComparisonOperator = "=="
+	ComparisonOperatorNotEqual           ComparisonOperator = "!="
+	ComparisonOperatorLessThan           ComparisonOperator = "<"
+	ComparisonOperatorLessThanOrEqual    ComparisonOperator = "<="
+	ComparisonOperatorGreaterThan        ComparisonOperator = ">"
+	ComparisonOperatorGreaterThanOrEqual ComparisonOperator = ">="
+	ComparisonOperatorIn                 ComparisonOperator = "=in="
+	ComparisonOperatorOut                ComparisonOperator = "=out="
+)
+
+var negatedComparisonOperators = map[ComparisonOperator]ComparisonOperator{
+	ComparisonOperatorEqual:              ComparisonOperatorNotEqual,
+	ComparisonOperatorNotEqual:           ComparisonOperatorEqual,
+	ComparisonOperatorLessThan:           ComparisonOperatorGreaterThanOrEqual,
+	ComparisonOperatorLessThanOrEqual:    ComparisonOperatorGreaterThan,
+	ComparisonOperatorGreaterThan:        ComparisonOperatorLessThanOrEqual,
+	ComparisonOperatorGreaterThanOrEqual: ComparisonOperatorLessThan,
+	ComparisonOperatorIn:                 ComparisonOperatorOut,
+	ComparisonOperatorOut:                ComparisonOperatorIn,
+}
+
+type comparison struct {
+	field    string
+	operator ComparisonOperator
+	values   []interface{}
+}
+
+func (c comparison) String() string {
+	if c.operator == ComparisonOperatorIn || c.operator == ComparisonOperatorOut {
+		// field=in=() is invalid. In with no values matches nothing, so it produces a contradiction on the field,
+		// while Out with no values matches everything, so it produces no expression.
+		if len(c.values) == 0 {
+			if c.operator == ComparisonOperatorIn {
+				return fmt.Sprintf("%s%s0;%s%s0", c.field, ComparisonOperatorEqual, c.field, ComparisonOperatorNotEqual)
+			}
+			return ""
+		}
+		values := make([]string, 0, len(c.values))
+		for _, v := range c.values {
+			values = append(values, formatRSQLValue(v))
+		}
+		return fmt.Sprintf("%s%s(%s)", c.field, c.operator, strings.Join(values, ","))
+	}
+
+	return fmt.Sprintf("%s%s%s", c.field, c.operator, formatRSQLValue(c.values[0]))
+}
+
+func (c comparison) negate() Filter {
+	c.operator = negatedComparisonOperators[c.operator]
+	return c
+}
+
+type logicalOperator string
+
+const (
+	logicalOperatorAnd logicalOperator = ";"
+	logicalOperatorOr  logicalOperator = ","
+)
+
+type logical struct {
+	operator logicalOperator
+	filters  []Filter
+}
+
+// An empty expression matches everything, so it is dropped from AND and makes OR match everything.
+func (l logical) String() string {
+	expressions := make([]string, 0, len(l.filters))
+	for _, f := range l.filters {
+		expression := f.String()
+		if expression == "" {
+			if l.operator == logicalOperatorOr {
+				return ""
+			}
+			continue
+		}
+		// AND has higher precedence than OR, so OR inside AND must be grouped.
+		if child, ok := f.(logical); ok && l.operator == logicalOperatorAnd && child.operator == logicalOperatorOr && len(child.filters) > 1 {
+			expression = fmt.Sprintf("(%s)", expression)
+		}
+		expressions = append(expressions, expression)
+	}
+
+	return strings.Join(expressions, string(l.operator))
+}
+
+// negate applies De Morgan's laws, because RSQL has no NOT operator.
+func (l logical) negate() Filter {
+	negated := logical{filters: make([]Filter, 0, len(l.filters))}
+	if l.operator == logicalOperatorAnd {
+		negated.operator = logicalOperatorOr
+	} else {
+		negated.operator = logicalOperatorAnd
+	}
+	for _, f := range l.filters {
+		negated.filters = append(negated.filters, f.negate())
+	}
+
+	return negated
+}
+
+// And matches when all the filters match.
+func And(filters ...Filter) Filter {
+	return logical{operator: logicalOperatorAnd, filters: filters}
+}
+
+// Or matches when any of the filters matches.
+func Or(filters ...Filter) Filter {
+	return logical{operator: logicalOperatorOr, filters: filters}
+}
+
+// Not matches when the filter does not match.
+// RSQL has no NOT operator, so the comparison operators are inverted instead.
+func Not(filter Filter) Filter {
+	return filter.negate()
+}
+
+// Eq matches when the field is equal to the value. A '*' in a string value is a wildcard.
+func Eq(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorEqual, values: []interface{}{value}}
+}
+
+// Ne matches when the field is not equal to the value. A '*' in a string value is a wildcard.
+func Ne(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorNotEqual, values: []interface{}{value}}
+}
+
+// Lt matches when the field is less than the value.
+func Lt(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorLessThan, values: []interface{}{value}}
+}
+
+// Le matches when the field is less than or equal to the value.
+func Le(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorLessThanOrEqual, values: []interface{}{value}}
+}
+
+// Gt matches when the field is greater than the value.
+func Gt(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorGreaterThan, values: []interface{}{value}}
+}
+
+// Ge matches when the field is greater than or equal to the value.
+func Ge(field string, value interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorGreaterThanOrEqual, values: []interface{}{value}}
+}
+
+// In matches when the field is equal to any of the values.
+// With no values, it matches nothing.
+func In(field string, values ...interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorIn, values: values}
+}
+
+// Out matches when the field is equal to none of the values.
+// With no values, it matches everything and produces no expression.
+func Out(field string, values ...interface{}) Filter {
+	return comparison{field: field, operator: ComparisonOperatorOut, values: values}
+}
+
+// StartsWith matches when the field starts with the prefix. A '*' in the prefix is matched literally.
+func StartsWith(field string, prefix string) Filter {
+	return Eq(field, wildcardPattern(escapeRSQLString(prefix)+"*"))
+}
+
+// EndsWith matches when the field ends with the suffix. A '*' in the suffix is matched literally.
+func EndsWith(field string, suffix string) Filter {
+	return Eq(field, wildcardPattern("*"+escapeRSQLString(suffix)))
+}
+
+// Contains matches when the field contains the substring. A '*' in the substring is matched literally.
+func Contains(field string, substr string) Filter {
+	return Eq(field, wildcardPattern("*"+escapeRSQLString(substr)+"*"))
+}
+
+// wildcardPattern is a string value whose literal parts are already escaped, so that only its unescaped '*' are wildcards.
+type wildcardPattern string
+
+// formatRSQLValue formats a value of a comparison.
+// Strings are always quoted so that reserved characters such as ',', ';' and ')' are treated as literals.
+func formatRSQLValue(v interface{}) string {
+	switch value := v.(type) {
+	case wildcardPattern:
+		return fmt.Sprintf(`"%s"`, value)
+	case string:
+		return quoteRSQLString(value)
+	case time.Time:
+		return quoteRSQLString(value.Format(time.RFC3339))
+	case fmt.Stringer:
+		return quoteRSQLString(value.String())
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func quoteRSQLString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, s)
+}
+
+// escapeRSQLString escapes a string so that its '*' are not wildcards.
+func escapeRSQLString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "*", `\*`)
+}
+
+// FilterValue returns the RSQL string of the filter for ListOptions.Filter.
+// It returns nil when the filter produces no expression and matches everything, e.g. Out with no values.
+func FilterValue(filter Filter) *string {
+	s := filter.String()
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+// Sort is a sort criterion of ListOptions.Sort.
+type Sort struct {
+	Field     string
+	Direction SortDirection
+}
+
+func (s Sort) String() string {
+	return fmt.Sprintf("%s:%s", s.Field, s.Direction)
+}
+
+// Asc sorts by the field in ascending order.
+func Asc(field string) Sort {
+	return Sort{Field: field, Direction: SortDirectionAsc}
+}
+
+// Desc sorts by the field in descending order.
+func Desc(field string) Sort {
+	return Sort{Field: field, Direction: SortDirectionDesc}
+}
+
+// SortValue returns the sort criteria for ListOptions.Sort.
+func SortValue(sorts ...Sort) *[]string {
+	values := make([]string, 0, len(sorts))
+	for _, s := range sorts {
+		values = append(values, s.String())
+	}
+	return &values
+}
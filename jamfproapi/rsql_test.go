@@ -0,0 +1,121 @@
+package jamfproapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestFilter_String(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{
+			name:   "equal with wildcard and greater than",
+			filter: And(Eq("name", "foo*"), Gt("priority", 5)),
+			want:   `name=="foo*";priority>5`,
+		},
+		{
+			name:   "nested properties",
+			filter: And(StartsWith("general.name", "mac"), Eq("general.remoteManagement.managed", true)),
+			want:   `general.name=="mac*";general.remoteManagement.managed==true`,
+		},
+		{
+			name:   "or",
+			filter: Or(Eq("categoryName", "Utilities"), Ne("categoryName", "None")),
+			want:   `categoryName=="Utilities",categoryName!="None"`,
+		},
+		{
+			name:   "or inside and is grouped",
+			filter: And(Le("priority", 10), Or(Contains("name", "test"), EndsWith("name", ".sh"))),
+			want:   `priority<=10;(name=="*test*",name=="*.sh")`,
+		},
+		{
+			name:   "and inside or",
+			filter: Or(And(Ge("id", 1), Lt("id", 5)), Eq("id", 10)),
+			want:   `id>=1;id<5,id==10`,
+		},
+		{
+			name:   "in and out",
+			filter: And(In("id", 1, 2, 3), Out("name", "a,b", "c")),
+			want:   `id=in=(1,2,3);name=out=("a,b","c")`,
+		},
+		{
+			name:   "quotes and backslashes are escaped",
+			filter: Eq("name", `say "hi" \o/`),
+			want:   `name=="say \"hi\" \\o/"`,
+		},
+		{
+			name:   "wildcards in the values of contains, starts with and ends with are escaped",
+			filter: Or(Contains("name", "a*b"), StartsWith("name", `c\*`), EndsWith("name", `"*`)),
+			want:   `name=="*a\*b*",name=="c\\\**",name=="*\"\*"`,
+		},
+		{
+			name:   "in with no values matches nothing",
+			filter: Or(In("id"), Eq("name", "foo")),
+			want:   `id==0;id!=0,name=="foo"`,
+		},
+		{
+			name:   "out with no values matches everything",
+			filter: And(Out("id"), Eq("name", "foo")),
+			want:   `name=="foo"`,
+		},
+		{
+			name:   "or with a filter matching everything matches everything",
+			filter: And(Eq("name", "foo"), Or(Not(In("id")), Eq("id", 1))),
+			want:   `name=="foo"`,
+		},
+		{
+			name:   "time",
+			filter: Gt("general.lastContactTime", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+			want:   `general.lastContactTime>"2023-01-02T03:04:05Z"`,
+		},
+		{
+			name:   "not comparison",
+			filter: Not(Eq("name", "foo")),
+			want:   `name!="foo"`,
+		},
+		{
+			name:   "not applies De Morgan's laws",
+			filter: Not(And(Lt("priority", 5), Or(In("id", 1, 2), Ge("id", 10)))),
+			want:   `priority>=5,id=out=(1,2);id<10`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.String(); got != tc.want {
+				t.Errorf("Filter.String() returned %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListOptions_FilterValueAndSortValue(t *testing.T) {
+	options := ListOptions{
+		Filter: FilterValue(And(Eq("name", "foo*"), Gt("priority", 5))),
+		Sort:   SortValue(Asc("name"), Desc("id")),
+	}
+
+	params, err := query.Values(options)
+	if err != nil {
+		t.Fatalf("query.Values(): %v", err)
+	}
+
+	if got, want := params.Get("filter"), `name=="foo*";priority>5`; got != want {
+		t.Errorf("params.Get(filter) returned %s, want %s", got, want)
+	}
+	if got, want := params.Get("sort"), "name:asc,id:desc"; got != want {
+		t.Errorf("params.Get(sort) returned %s, want %s", got, want)
+	}
+
+	if got := FilterValue(In("id")); got == nil || *got != `id==0;id!=0` {
+		t.Errorf("FilterValue(In()) returned %v, want a filter matching nothing", got)
+	}
+	if got := FilterValue(Out("id")); got != nil {
+		t.Errorf("FilterValue(Out()) returned %s, want nil", *got)
+	}
+}
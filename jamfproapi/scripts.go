@@ -59,7 +59,7 @@ func (s *ScriptsService) Create(ctx context.Context, script *Script) (*string, *
 
 	body, err := json.Marshal(script)
 	if err != nil {
-		return nil, nil, fmt.Errorf("json.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Post(ctx, jamf.PostHttpRequestInput{
@@ -71,13 +71,13 @@ func (s *ScriptsService) Create(ctx context.Context, script *Script) (*string, *
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var data struct {
@@ -85,7 +85,7 @@ func (s *ScriptsService) Create(ctx context.Context, script *Script) (*string, *
 		Href string `json:"href"`
 	}
 	if err := json.Unmarshal(respBody, &data); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &data.ID, resp, nil
@@ -100,7 +100,7 @@ func (s *ScriptsService) Delete(ctx context.Context, scriptID string) (*jamf.Res
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("client.Delete(): %v", err)
+		return nil, fmt.Errorf("client.Delete(): %w", err)
 	}
 
 	return resp, nil
@@ -115,18 +115,18 @@ func (s *ScriptsService) Get(ctx context.Context, scriptID string) (*Script, *ja
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var script Script
 	if err := json.Unmarshal(respBody, &script); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &script, resp, nil
@@ -135,7 +135,7 @@ func (s *ScriptsService) Get(ctx context.Context, scriptID string) (*Script, *ja
 func (s *ScriptsService) List(ctx context.Context, options ListOptions) (*ListScript, *jamf.Response, error) {
 	params, err := query.Values(options)
 	if err != nil {
-		return nil, nil, fmt.Errorf("query.Values(): %v", err)
+		return nil, nil, fmt.Errorf("query.Values(): %w", err)
 	}
 
 	resp, _, err := s.client.Get(ctx, jamf.GetHttpRequestInput{
@@ -147,23 +147,43 @@ func (s *ScriptsService) List(ctx context.Context, options ListOptions) (*ListSc
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, nil, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var listScript ListScript
 	if err := json.Unmarshal(respBody, &listScript); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &listScript, resp, nil
 }
 
+// ListAll fetches all pages of the list.
+func (s *ScriptsService) ListAll(ctx context.Context, options ListAllOptions) ([]Script, error) {
+	return ListAll(ctx, s.listPage, options)
+}
+
+// ListPager returns a Pager which walks the pages of the list one by one.
+func (s *ScriptsService) ListPager(options ListOptions) *Pager[Script] {
+	return NewPager(s.listPage, options)
+}
+
+func (s *ScriptsService) listPage(ctx context.Context, options ListOptions) ([]Script, int, error) {
+	list, _, err := s.List(ctx, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, totalCount := pageResults(list.Scripts, list.TotalCount)
+	return results, totalCount, nil
+}
+
 func (s *ScriptsService) Update(ctx context.Context, script *Script) (*Script, *jamf.Response, error) {
 	if script.ID == nil {
 		return nil, nil, errors.New("ScriptsService.Update(): cannot update script with nil ID")
@@ -174,7 +194,7 @@ func (s *ScriptsService) Update(ctx context.Context, script *Script) (*Script, *
 
 	body, err := json.Marshal(script)
 	if err != nil {
-		return nil, nil, fmt.Errorf("json.Marshal(): %v", err)
+		return nil, nil, fmt.Errorf("json.Marshal(): %w", err)
 	}
 
 	resp, _, err := s.client.Put(ctx, jamf.PutHttpRequestInput{
@@ -186,18 +206,18 @@ func (s *ScriptsService) Update(ctx context.Context, script *Script) (*Script, *
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Put(): %v", err)
+		return nil, nil, fmt.Errorf("client.Put(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, nil, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var newScript Script
 	if err := json.Unmarshal(respBody, &newScript); err != nil {
-		return nil, nil, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, nil, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &newScript, nil, nil
@@ -247,3 +247,35 @@ func TestScriptsService_Update(t *testing.T) {
 		t.Fatalf("Scripts.Update() returned %s, want %s", formatWithSpew(script), formatWithSpew(want))
 	}
 }
+
+func TestScriptsService_ListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(buildHandlePath(scriptsPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "0":
+			_, _ = w.Write(compactJSON([]byte(`{"totalCount": 3, "results": [{"id": "1"}, {"id": "2"}]}`)))
+		case "1":
+			_, _ = w.Write(compactJSON([]byte(`{"totalCount": 3, "results": [{"id": "3"}]}`)))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ctx := context.Background()
+	scripts, err := client.Scripts.ListAll(ctx, ListAllOptions{
+		ListOptions: ListOptions{PageSize: ptr(2)},
+	})
+	if err != nil {
+		t.Fatalf("Scripts.ListAll(): %v", err)
+	}
+
+	want := []Script{{ID: ptr("1")}, {ID: ptr("2")}, {ID: ptr("3")}}
+	if !cmp.Equal(scripts, want) {
+		t.Errorf("Scripts.ListAll() returned %s, want %s", formatWithSpew(scripts), formatWithSpew(want))
+	}
+}
@@ -30,18 +30,18 @@ func (s *SSOFailoverService) Get(ctx context.Context) (*SSOFailover, *jamf.Respo
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Get(): %v", err)
+		return nil, nil, fmt.Errorf("client.Get(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, nil, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var ssoFailover SSOFailover
 	if err := json.Unmarshal(respBody, &ssoFailover); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &ssoFailover, resp, nil
@@ -56,18 +56,18 @@ func (s *SSOFailoverService) Generate(ctx context.Context) (*SSOFailover, *jamf.
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("client.Post(): %v", err)
+		return nil, nil, fmt.Errorf("client.Post(): %w", err)
 	}
 	defer utils.HandleCloseFunc(resp.Body, s.client.RetryableClient.Logger)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp, fmt.Errorf("io.ReadAll(): %v", err)
+		return nil, resp, fmt.Errorf("io.ReadAll(): %w", err)
 	}
 
 	var ssoFailover SSOFailover
 	if err := json.Unmarshal(respBody, &ssoFailover); err != nil {
-		return nil, resp, fmt.Errorf("json.Unmarshal(): %v", err)
+		return nil, resp, fmt.Errorf("json.Unmarshal(): %w", err)
 	}
 
 	return &ssoFailover, resp, nil
@@ -0,0 +1,146 @@
+package jamfproapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+)
+
+type basicAuthTokenProvider struct {
+	client   *Client
+	username string
+	password string
+}
+
+// NewBasicAuthTokenSource returns a token source which acquires a token from /v1/auth/token with the username and password,
+// and renews it through /v1/auth/keep-alive before it expires.
+func NewBasicAuthTokenSource(serverURL string, username string, password string) (*jamf.CachedTokenSource, error) {
+	// The token source uses its own client, because the client authorized by this token source cannot acquire the token.
+	client, err := NewClient(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient(): %w", err)
+	}
+
+	return newBasicAuthTokenSource(client, username, password), nil
+}
+
+func newBasicAuthTokenSource(client *Client, username string, password string) *jamf.CachedTokenSource {
+	return jamf.NewCachedTokenSource(&basicAuthTokenProvider{
+		client:   client,
+		username: username,
+		password: password,
+	})
+}
+
+func (p *basicAuthTokenProvider) Acquire(ctx context.Context) (*jamf.Token, error) {
+	authToken, _, err := p.client.APIAuthentication.Token(ctx, p.username, p.password)
+	if err != nil {
+		return nil, fmt.Errorf("APIAuthentication.Token(): %w", err)
+	}
+
+	return authToken.toToken()
+}
+
+func (p *basicAuthTokenProvider) Renew(ctx context.Context, token *jamf.Token) (*jamf.Token, error) {
+	authToken, _, err := p.client.APIAuthentication.keepAlive(ctx, bearerTokenMiddlewareFunc(token))
+	if err != nil {
+		return nil, fmt.Errorf("APIAuthentication.keepAlive(): %w", err)
+	}
+
+	return authToken.toToken()
+}
+
+func (p *basicAuthTokenProvider) Revoke(ctx context.Context, token *jamf.Token) error {
+	return revokeToken(ctx, p.client, token)
+}
+
+type clientCredentialsTokenProvider struct {
+	client       *Client
+	clientID     string
+	clientSecret string
+}
+
+// NewClientCredentialsTokenSource returns a token source which acquires an access token from /oauth/token
+// with the client ID and secret of an API client, and acquires a new one before it expires.
+func NewClientCredentialsTokenSource(serverURL string, clientID string, clientSecret string) (*jamf.CachedTokenSource, error) {
+	client, err := NewClient(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient(): %w", err)
+	}
+
+	return newClientCredentialsTokenSource(client, clientID, clientSecret), nil
+}
+
+func newClientCredentialsTokenSource(client *Client, clientID string, clientSecret string) *jamf.CachedTokenSource {
+	return jamf.NewCachedTokenSource(&clientCredentialsTokenProvider{
+		client:       client,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+	})
+}
+
+func (p *clientCredentialsTokenProvider) Acquire(ctx context.Context) (*jamf.Token, error) {
+	issuedAt := time.Now()
+	oauthToken, _, err := p.client.OAuth.Token(ctx, p.clientID, p.clientSecret)
+	if err != nil {
+		return nil, fmt.Errorf("OAuth.Token(): %w", err)
+	}
+
+	if oauthToken.AccessToken == nil {
+		return nil, errors.New("clientCredentialsTokenProvider.Acquire(): received access token with nil AccessToken")
+	}
+	if oauthToken.ExpiresIn == nil {
+		return nil, errors.New("clientCredentialsTokenProvider.Acquire(): received access token with nil ExpiresIn")
+	}
+
+	return &jamf.Token{
+		AccessToken: *oauthToken.AccessToken,
+		ExpiresAt:   issuedAt.Add(time.Duration(*oauthToken.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// Renew is not supported, because the client credentials grant does not issue refresh tokens.
+func (p *clientCredentialsTokenProvider) Renew(_ context.Context, _ *jamf.Token) (*jamf.Token, error) {
+	return nil, jamf.ErrTokenRenewalNotSupported
+}
+
+func (p *clientCredentialsTokenProvider) Revoke(ctx context.Context, token *jamf.Token) error {
+	return revokeToken(ctx, p.client, token)
+}
+
+func revokeToken(ctx context.Context, client *Client, token *jamf.Token) error {
+	if _, err := client.APIAuthentication.invalidateToken(ctx, bearerTokenMiddlewareFunc(token)); err != nil {
+		return fmt.Errorf("APIAuthentication.invalidateToken(): %w", err)
+	}
+
+	return nil
+}
+
+func bearerTokenMiddlewareFunc(token *jamf.Token) jamf.RequestMiddlewareFunc {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+	}
+}
+
+func (t *AuthToken) toToken() (*jamf.Token, error) {
+	if t.Token == nil {
+		return nil, errors.New("AuthToken.toToken(): cannot convert auth token with nil Token")
+	}
+	if t.Expires == nil {
+		return nil, errors.New("AuthToken.toToken(): cannot convert auth token with nil Expires")
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, *t.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("time.Parse(): %w", err)
+	}
+
+	return &jamf.Token{
+		AccessToken: *t.Token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
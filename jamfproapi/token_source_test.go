@@ -0,0 +1,262 @@
+package jamfproapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kenchan0130/go-jamf-pro/jamf"
+)
+
+func TestNewBasicAuthTokenSource(t *testing.T) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "token"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if username, password, ok := r.BasicAuth(); !ok || username != "dummyUsername" || password != "dummyPassword" {
+			t.Errorf("BasicAuth() returned (%q, %q, %v), want (\"dummyUsername\", \"dummyPassword\", true)", username, password, ok)
+		}
+
+		n := atomic.AddInt32(&issued, 1)
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "token-%d",
+			"expires": "%s"
+		}`, n, time.Now().Add(time.Minute*30).Format(time.RFC3339)))))
+	})
+	mux.HandleFunc(buildHandlePath(ssoFailoverPath), func(w http.ResponseWriter, r *http.Request) {
+		// The first token is regarded as revoked at the server side.
+		if got := r.Header.Get("Authorization"); got != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	tokenSource, err := NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
+	if err != nil {
+		t.Fatalf("NewBasicAuthTokenSource(): %v", err)
+	}
+	client.TokenSource = tokenSource
+
+	ctx := context.Background()
+	if _, _, err := client.SSOFailover.Get(ctx); err != nil {
+		t.Fatalf("SSOFailover.Get(): %v", err)
+	}
+	if _, _, err := client.SSOFailover.Get(ctx); err != nil {
+		t.Fatalf("SSOFailover.Get(): %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&issued), int32(2); got != want {
+		t.Errorf("token was issued %d times, want %d", got, want)
+	}
+}
+
+func TestNewBasicAuthTokenSource_KeepAlive(t *testing.T) {
+	now := time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	var renewed int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "token"), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "token",
+			"expires": "%s"
+		}`, now.Add(time.Minute*30).Format(time.RFC3339)))))
+	})
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "keep-alive"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer token\"", got)
+		}
+
+		atomic.AddInt32(&renewed, 1)
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "renewed-token",
+			"expires": "%s"
+		}`, now.Add(time.Minute*60).Format(time.RFC3339)))))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tokenSource, err := NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
+	if err != nil {
+		t.Fatalf("NewBasicAuthTokenSource(): %v", err)
+	}
+	clock := now
+	tokenSource.Now = func() time.Time { return clock }
+
+	ctx := context.Background()
+	if _, err := tokenSource.Token(ctx); err != nil {
+		t.Fatalf("CachedTokenSource.Token(): %v", err)
+	}
+
+	// The token is still cached until RenewBefore ahead of its expiration.
+	clock = now.Add(time.Minute * 24)
+	if token, err := tokenSource.Token(ctx); err != nil || token != "token" {
+		t.Fatalf("CachedTokenSource.Token() returned (%q, %v), want (\"token\", nil)", token, err)
+	}
+
+	clock = now.Add(time.Minute * 26)
+	token, err := tokenSource.Token(ctx)
+	if err != nil {
+		t.Fatalf("CachedTokenSource.Token(): %v", err)
+	}
+	if want := "renewed-token"; token != want {
+		t.Errorf("CachedTokenSource.Token() returned %q, want %q", token, want)
+	}
+	if got, want := atomic.LoadInt32(&renewed), int32(1); got != want {
+		t.Errorf("token was renewed %d times, want %d", got, want)
+	}
+}
+
+func TestNewClientCredentialsTokenSource(t *testing.T) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(buildHandlePath(oauthTokenPath), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm(): %v", err)
+		}
+		if got := r.PostForm.Get("client_id"); got != "dummyClientID" {
+			t.Errorf("PostForm.Get(client_id) returned %q, want \"dummyClientID\"", got)
+		}
+
+		atomic.AddInt32(&issued, 1)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"access_token": "access-token",
+			"scope": "api-role:1",
+			"token_type": "Bearer",
+			"expires_in": 299
+		}`)))
+	})
+	mux.HandleFunc(buildHandlePath(ssoFailoverPath), func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer access-token\"", got)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	tokenSource, err := NewClientCredentialsTokenSource(server.URL, "dummyClientID", "dummyClientSecret")
+	if err != nil {
+		t.Fatalf("NewClientCredentialsTokenSource(): %v", err)
+	}
+	client.TokenSource = tokenSource
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.SSOFailover.Get(ctx); err != nil {
+			t.Fatalf("SSOFailover.Get(): %v", err)
+		}
+	}
+
+	if got, want := atomic.LoadInt32(&issued), int32(1); got != want {
+		t.Errorf("access token was issued %d times, want %d", got, want)
+	}
+}
+
+func TestClientCredentialsAuthorizer(t *testing.T) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(buildHandlePath(oauthTokenPath), func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&issued, 1)
+		_, _ = w.Write(compactJSON([]byte(`{
+			"access_token": "access-token",
+			"token_type": "Bearer",
+			"expires_in": 299
+		}`)))
+	})
+	mux.HandleFunc(buildHandlePath(ssoFailoverPath), func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer access-token\"", got)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	authorizer := &ClientCredentialsAuthorizer{
+		BaseURL:      serverURL,
+		ClientID:     "dummyClientID",
+		ClientSecret: "dummyClientSecret",
+	}
+	if _, _, ok := authorizer.BasicAuth(); ok {
+		t.Error("ClientCredentialsAuthorizer.BasicAuth() returned true, want false")
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		client, _ := NewClient(server.URL, jamf.WithAuthorizer(authorizer))
+		if _, _, err := client.SSOFailover.Get(ctx); err != nil {
+			t.Fatalf("SSOFailover.Get(): %v", err)
+		}
+	}
+
+	if got, want := atomic.LoadInt32(&issued), int32(1); got != want {
+		t.Errorf("access token was issued %d times, want %d", got, want)
+	}
+}
+
+func TestNewBasicAuthTokenSource_Revoke(t *testing.T) {
+	var invalidated int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "token"), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(compactJSON([]byte(fmt.Sprintf(`{
+			"token": "token",
+			"expires": "%s"
+		}`, time.Now().Add(time.Minute*30).Format(time.RFC3339)))))
+	})
+	mux.HandleFunc(buildHandlePath(apiAuthenticationPath, "invalidate-token"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Header.Get(Authorization) returned %q, want \"Bearer token\"", got)
+		}
+
+		atomic.AddInt32(&invalidated, 1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(buildHandlePath(ssoFailoverPath), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tokenSource, err := NewBasicAuthTokenSource(server.URL, "dummyUsername", "dummyPassword")
+	if err != nil {
+		t.Fatalf("NewBasicAuthTokenSource(): %v", err)
+	}
+	client, _ := NewClient(server.URL, jamf.WithTokenSource(tokenSource))
+
+	if _, _, err := client.SSOFailover.Get(context.Background()); err != nil {
+		t.Fatalf("SSOFailover.Get(): %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	// The token has already been revoked, so closing again does nothing.
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+
+	if got, want := atomic.LoadInt32(&invalidated), int32(1); got != want {
+		t.Errorf("token was invalidated %d times, want %d", got, want)
+	}
+}
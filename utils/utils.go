@@ -6,11 +6,24 @@ import (
 	"log"
 )
 
-// HandleCloseFunc can be used to close an io.ReadCloser with message
+// HandleCloseFunc can be used to close an io.ReadCloser with message.
+// The logger can be a *log.Logger, a leveled logger such as *slog.Logger or retryablehttp.LeveledLogger, or nil.
 func HandleCloseFunc(v io.ReadCloser, logger interface{}) {
 	if err := v.Close(); err != nil {
-		l := logger.(*log.Logger)
-		if l != nil {
+		switch l := logger.(type) {
+		case *log.Logger:
+			if l != nil {
+				l.Printf("Error closing io: %v", err)
+				return
+			}
+		case interface {
+			Error(msg string, keysAndValues ...interface{})
+		}:
+			l.Error("Error closing io", "error", err)
+			return
+		case interface {
+			Printf(format string, v ...interface{})
+		}:
 			l.Printf("Error closing io: %v", err)
 			return
 		}